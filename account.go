@@ -1,9 +1,66 @@
 package tmdb
 
 import (
+	"errors"
 	"fmt"
+	"math"
+	"net/url"
 )
 
+// MediaType type
+type MediaType string
+
+// Media types accepted by the favorite and watchlist methods
+const (
+	MediaTypeMovie MediaType = "movie"
+	MediaTypeTv    MediaType = "tv"
+)
+
+// ErrInvalidRating is returned for ratings outside of 0.5 to 10 or not a
+// multiple of 0.5
+var ErrInvalidRating = errors.New("rating must be a multiple of 0.5 between 0.5 and 10")
+
+type favoriteBody struct {
+	MediaType MediaType `json:"media_type"`
+	MediaID   int       `json:"media_id"`
+	Favorite  bool      `json:"favorite"`
+}
+
+type watchlistBody struct {
+	MediaType MediaType `json:"media_type"`
+	MediaID   int       `json:"media_id"`
+	Watchlist bool      `json:"watchlist"`
+}
+
+type ratingBody struct {
+	Value float32 `json:"value"`
+}
+
+func newRatingBody(value float32) (*ratingBody, error) {
+	if value < 0.5 || value > 10 || math.Mod(float64(value), 0.5) != 0 {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRating, value)
+	}
+	return &ratingBody{Value: value}, nil
+}
+
+// rate posts a rating to path, authenticated by query
+func (tmdb *TMDb) rate(path string, query url.Values, value float32) (*Status, error) {
+	body, err := newRatingBody(value)
+	if err != nil {
+		return nil, err
+	}
+	var status Status
+	result, err := tmdb.postTmdb(tmdb.buildURL(path, query), body, &status)
+	return result.(*Status), err
+}
+
+// deleteRating deletes the rating at path, authenticated by query
+func (tmdb *TMDb) deleteRating(path string, query url.Values) (*Status, error) {
+	var status Status
+	result, err := tmdb.deleteTmdb(tmdb.buildURL(path, query), nil, &status)
+	return result.(*Status), err
+}
+
 // AccountInfo struct
 type AccountInfo struct {
 	ID           int
@@ -18,8 +75,8 @@ type AccountInfo struct {
 // https://developers.themoviedb.org/3/account/get-account-details
 func (tmdb *TMDb) GetAccountInfo(sessionID string) (*AccountInfo, error) {
 	var account AccountInfo
-	uri := fmt.Sprintf("%s/account?api_key=%s&session_id=%s", baseURL, tmdb.apiKey, sessionID)
-	result, err := getTmdb(uri, &account)
+	uri := tmdb.buildURL("/account", url.Values{"session_id": {sessionID}})
+	result, err := tmdb.getTmdb(uri, &account)
 	return result.(*AccountInfo), err
 }
 
@@ -30,9 +87,13 @@ func (tmdb *TMDb) GetAccountLists(id int, sessionID string, options map[string]s
 		"page":     {},
 		"language": {}}
 	var lists MovieLists
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/account/%v/lists?api_key=%s&session_id=%s%s", baseURL, id, tmdb.apiKey, sessionID, optionsString)
-	result, err := getTmdb(uri, &lists)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("session_id", sessionID)
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/lists", id), query)
+	result, err := tmdb.getTmdb(uri, &lists)
 	return result.(*MovieLists), err
 }
 
@@ -44,9 +105,13 @@ func (tmdb *TMDb) GetAccountFavoriteMovies(id int, sessionID string, options map
 		"sort_by":  {},
 		"language": {}}
 	var favorites MoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/account/%v/favorite/movies?api_key=%s&session_id=%s%s", baseURL, id, tmdb.apiKey, sessionID, optionsString)
-	result, err := getTmdb(uri, &favorites)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("session_id", sessionID)
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/favorite/movies", id), query)
+	result, err := tmdb.getTmdb(uri, &favorites)
 	return result.(*MoviePagedResults), err
 }
 
@@ -58,9 +123,13 @@ func (tmdb *TMDb) GetAccountFavoriteTv(id int, sessionID string, options map[str
 		"sort_by":  {},
 		"language": {}}
 	var favorites TvPagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/account/%v/favorite/tv?api_key=%s&session_id=%s%s", baseURL, id, tmdb.apiKey, sessionID, optionsString)
-	result, err := getTmdb(uri, &favorites)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("session_id", sessionID)
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/favorite/tv", id), query)
+	result, err := tmdb.getTmdb(uri, &favorites)
 	return result.(*TvPagedResults), err
 }
 
@@ -72,9 +141,13 @@ func (tmdb *TMDb) GetAccountRatedMovies(id int, sessionID string, options map[st
 		"sort_by":  {},
 		"language": {}}
 	var favorites MoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/account/%v/rated/movies?api_key=%s&session_id=%s%s", baseURL, id, tmdb.apiKey, sessionID, optionsString)
-	result, err := getTmdb(uri, &favorites)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("session_id", sessionID)
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/rated/movies", id), query)
+	result, err := tmdb.getTmdb(uri, &favorites)
 	return result.(*MoviePagedResults), err
 }
 
@@ -86,9 +159,13 @@ func (tmdb *TMDb) GetAccountRatedTv(id int, sessionID string, options map[string
 		"sort_by":  {},
 		"language": {}}
 	var favorites TvPagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/account/%v/rated/tv?api_key=%s&session_id=%s%s", baseURL, id, tmdb.apiKey, sessionID, optionsString)
-	result, err := getTmdb(uri, &favorites)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("session_id", sessionID)
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/rated/tv", id), query)
+	result, err := tmdb.getTmdb(uri, &favorites)
 	return result.(*TvPagedResults), err
 }
 
@@ -100,9 +177,13 @@ func (tmdb *TMDb) GetAccountWatchlistMovies(id int, sessionID string, options ma
 		"sort_by":  {},
 		"language": {}}
 	var favorites MoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/account/%v/watchlist/movies?api_key=%s&session_id=%s%s", baseURL, id, tmdb.apiKey, sessionID, optionsString)
-	result, err := getTmdb(uri, &favorites)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("session_id", sessionID)
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/watchlist/movies", id), query)
+	result, err := tmdb.getTmdb(uri, &favorites)
 	return result.(*MoviePagedResults), err
 }
 
@@ -114,8 +195,50 @@ func (tmdb *TMDb) GetAccountWatchlistTv(id int, sessionID string, options map[st
 		"sort_by":  {},
 		"language": {}}
 	var favorites TvPagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/account/%v/watchlist/tv?api_key=%s&session_id=%s%s", baseURL, id, tmdb.apiKey, sessionID, optionsString)
-	result, err := getTmdb(uri, &favorites)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("session_id", sessionID)
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/watchlist/tv", id), query)
+	result, err := tmdb.getTmdb(uri, &favorites)
 	return result.(*TvPagedResults), err
 }
+
+// MarkFavorite adds a movie or TV show to the favorites of an account
+// https://developers.themoviedb.org/3/account/mark-as-favorite
+func (tmdb *TMDb) MarkFavorite(id int, sessionID string, mediaType MediaType, mediaID int) (*Status, error) {
+	return tmdb.setFavorite(id, sessionID, favoriteBody{mediaType, mediaID, true})
+}
+
+// UnmarkFavorite removes a movie or TV show from the favorites of an account
+// https://developers.themoviedb.org/3/account/mark-as-favorite
+func (tmdb *TMDb) UnmarkFavorite(id int, sessionID string, mediaType MediaType, mediaID int) (*Status, error) {
+	return tmdb.setFavorite(id, sessionID, favoriteBody{mediaType, mediaID, false})
+}
+
+func (tmdb *TMDb) setFavorite(id int, sessionID string, body favoriteBody) (*Status, error) {
+	var status Status
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/favorite", id), url.Values{"session_id": {sessionID}})
+	result, err := tmdb.postTmdb(uri, body, &status)
+	return result.(*Status), err
+}
+
+// AddToWatchlist adds a movie or TV show to the watchlist of an account
+// https://developers.themoviedb.org/3/account/add-to-watchlist
+func (tmdb *TMDb) AddToWatchlist(id int, sessionID string, mediaType MediaType, mediaID int) (*Status, error) {
+	return tmdb.setWatchlist(id, sessionID, watchlistBody{mediaType, mediaID, true})
+}
+
+// RemoveFromWatchlist removes a movie or TV show from the watchlist of an account
+// https://developers.themoviedb.org/3/account/add-to-watchlist
+func (tmdb *TMDb) RemoveFromWatchlist(id int, sessionID string, mediaType MediaType, mediaID int) (*Status, error) {
+	return tmdb.setWatchlist(id, sessionID, watchlistBody{mediaType, mediaID, false})
+}
+
+func (tmdb *TMDb) setWatchlist(id int, sessionID string, body watchlistBody) (*Status, error) {
+	var status Status
+	uri := tmdb.buildURL(fmt.Sprintf("/account/%v/watchlist", id), url.Values{"session_id": {sessionID}})
+	result, err := tmdb.postTmdb(uri, body, &status)
+	return result.(*Status), err
+}
@@ -44,3 +44,31 @@ func (s *TmdbSuite) TestGetAccountWatchlistTv(c *C) {
 	result, err := s.tmdb.GetAccountWatchlistTv(s.accountID, s.session, nil)
 	s.baseTest(&result, err, c)
 }
+
+func (s *LocalSuite) TestFavoriteAndWatchlist(c *C) {
+	tmdb, server, requests := s.newWriteServer(c)
+	defer server.Close()
+
+	status, err := tmdb.MarkFavorite(7, "session", MediaTypeMovie, fightClubID)
+	c.Assert(err, IsNil)
+	c.Assert(status.Success, Equals, true)
+	_, err = tmdb.UnmarkFavorite(7, "session", MediaTypeTv, gameOfThronesID)
+	c.Assert(err, IsNil)
+	_, err = tmdb.AddToWatchlist(7, "session", MediaTypeMovie, fightClubID)
+	c.Assert(err, IsNil)
+	_, err = tmdb.RemoveFromWatchlist(7, "session", MediaTypeTv, gameOfThronesID)
+	c.Assert(err, IsNil)
+
+	c.Assert(*requests, HasLen, 4)
+	for _, req := range *requests {
+		c.Check(req.method, Equals, "POST")
+		c.Check(req.query.Get("session_id"), Equals, "session")
+		c.Check(req.contentType, Equals, "application/json;charset=utf-8")
+	}
+	c.Check((*requests)[0].path, Equals, "/account/7/favorite")
+	c.Check((*requests)[0].body, DeepEquals, map[string]interface{}{"media_type": "movie", "media_id": 550.0, "favorite": true})
+	c.Check((*requests)[1].body, DeepEquals, map[string]interface{}{"media_type": "tv", "media_id": 1399.0, "favorite": false})
+	c.Check((*requests)[2].path, Equals, "/account/7/watchlist")
+	c.Check((*requests)[2].body, DeepEquals, map[string]interface{}{"media_type": "movie", "media_id": 550.0, "watchlist": true})
+	c.Check((*requests)[3].body, DeepEquals, map[string]interface{}{"media_type": "tv", "media_id": 1399.0, "watchlist": false})
+}
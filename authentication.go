@@ -1,14 +1,39 @@
 package tmdb
 
 import (
-	"fmt"
+	"encoding/json"
+	"net/url"
+	"time"
 )
 
-// AuthenticationToken struct
+// expiryFormat is the layout of the expires_at fields, e.g.
+// "2016-08-26 17:04:39 UTC"
+const expiryFormat = "2006-01-02 15:04:05 MST"
+
+// AuthenticationToken struct. Expires is parsed from ExpiresAt when the token
+// is decoded.
 type AuthenticationToken struct {
 	Success      bool
-	RequestToken string `json:"request_token"`
-	ExpiresAt    string `json:"expires_at,omitempty"`
+	RequestToken string    `json:"request_token"`
+	ExpiresAt    string    `json:"expires_at,omitempty"`
+	Expires      time.Time `json:"-"`
+}
+
+// UnmarshalJSON decodes a token. A missing or malformed expires_at leaves
+// Expires as the zero time.
+func (token *AuthenticationToken) UnmarshalJSON(data []byte) error {
+	type plain AuthenticationToken
+	if err := json.Unmarshal(data, (*plain)(token)); err != nil {
+		return err
+	}
+	token.Expires = parseExpiry(token.ExpiresAt)
+	return nil
+}
+
+// Expired reports whether the token has expired. A token without an expiry is
+// considered expired.
+func (token *AuthenticationToken) Expired() bool {
+	return !time.Now().Before(token.Expires)
 }
 
 // AuthenticationSession struct
@@ -17,28 +42,73 @@ type AuthenticationSession struct {
 	SessionID string `json:"session_id"`
 }
 
-// AuthenticationGuestSession struct
+// AuthenticationGuestSession struct. Expires is parsed from ExpiresAt when
+// the session is decoded.
 type AuthenticationGuestSession struct {
 	Success        bool
-	GuestSessionID string `json:"guest_session_id"`
-	ExpiresAt      string `json:"expires_at"`
+	GuestSessionID string    `json:"guest_session_id"`
+	ExpiresAt      string    `json:"expires_at"`
+	Expires        time.Time `json:"-"`
+}
+
+// UnmarshalJSON decodes a guest session. A missing or malformed expires_at leaves
+// Expires as the zero time.
+func (session *AuthenticationGuestSession) UnmarshalJSON(data []byte) error {
+	type plain AuthenticationGuestSession
+	if err := json.Unmarshal(data, (*plain)(session)); err != nil {
+		return err
+	}
+	session.Expires = parseExpiry(session.ExpiresAt)
+	return nil
+}
+
+// Expired reports whether the guest session has expired. A session without
+// an expiry is considered expired.
+func (session *AuthenticationGuestSession) Expired() bool {
+	return !time.Now().Before(session.Expires)
+}
+
+// parseExpiry parses an expires_at value, returning the zero time when it is
+// missing or malformed
+func parseExpiry(expiresAt string) time.Time {
+	expires, err := time.Parse(expiryFormat, expiresAt)
+	if err != nil {
+		return time.Time{}
+	}
+	return expires
 }
 
 // GetAuthToken generates a valid request token for user based authentication
 // https://developers.themoviedb.org/3/authentication/create-request-token
 func (tmdb *TMDb) GetAuthToken() (*AuthenticationToken, error) {
 	var token AuthenticationToken
-	uri := fmt.Sprintf("%s/authentication/token/new?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &token)
+	uri := tmdb.buildURL("/authentication/token/new", nil)
+	result, err := tmdb.getTmdb(uri, &token)
 	return result.(*AuthenticationToken), err
 }
 
 // GetAuthValidateToken authenticates a user with a TMDb username and password
 // https://developers.themoviedb.org/3/authentication/validate-request-token
+//
+// Deprecated: Use ValidateAuthToken. This method used to send the password in
+// the query string and now forwards to ValidateAuthToken.
 func (tmdb *TMDb) GetAuthValidateToken(token, user, password string) (*AuthenticationToken, error) {
+	return tmdb.ValidateAuthToken(token, user, password)
+}
+
+// ValidateAuthToken authenticates a user with a TMDb username and password.
+// The credentials are sent in the request body, so they stay out of URLs and
+// logs.
+// https://developers.themoviedb.org/3/authentication/validate-request-token
+func (tmdb *TMDb) ValidateAuthToken(token, user, password string) (*AuthenticationToken, error) {
+	body := struct {
+		RequestToken string `json:"request_token"`
+		Username     string `json:"username"`
+		Password     string `json:"password"`
+	}{token, user, password}
 	var validToken AuthenticationToken
-	uri := fmt.Sprintf("%s/authentication/token/validate_with_login?api_key=%s&request_token=%s&username=%s&password=%s", baseURL, tmdb.apiKey, token, user, password)
-	result, err := getTmdb(uri, &validToken)
+	uri := tmdb.buildURL("/authentication/token/validate_with_login", nil)
+	result, err := tmdb.postTmdb(uri, body, &validToken)
 	return result.(*AuthenticationToken), err
 }
 
@@ -46,8 +116,8 @@ func (tmdb *TMDb) GetAuthValidateToken(token, user, password string) (*Authentic
 // https://developers.themoviedb.org/3/authentication/create-session
 func (tmdb *TMDb) GetAuthSession(token string) (*AuthenticationSession, error) {
 	var session AuthenticationSession
-	uri := fmt.Sprintf("%s/authentication/session/new?api_key=%s&request_token=%s", baseURL, tmdb.apiKey, token)
-	result, err := getTmdb(uri, &session)
+	uri := tmdb.buildURL("/authentication/session/new", url.Values{"request_token": {token}})
+	result, err := tmdb.getTmdb(uri, &session)
 	return result.(*AuthenticationSession), err
 }
 
@@ -55,7 +125,31 @@ func (tmdb *TMDb) GetAuthSession(token string) (*AuthenticationSession, error) {
 // https://developers.themoviedb.org/3/authentication/create-guest-session
 func (tmdb *TMDb) GetAuthGuestSession() (*AuthenticationGuestSession, error) {
 	var session AuthenticationGuestSession
-	uri := fmt.Sprintf("%s/authentication/guest_session/new?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &session)
+	uri := tmdb.buildURL("/authentication/guest_session/new", nil)
+	result, err := tmdb.getTmdb(uri, &session)
 	return result.(*AuthenticationGuestSession), err
 }
+
+// ConvertAuthAccessToken creates a v3 session id from a v4 user access token
+// https://developers.themoviedb.org/3/authentication/create-session-from-v4-access-token
+func (tmdb *TMDb) ConvertAuthAccessToken(accessToken string) (*AuthenticationSession, error) {
+	body := struct {
+		AccessToken string `json:"access_token"`
+	}{accessToken}
+	var session AuthenticationSession
+	uri := tmdb.buildURL("/authentication/session/convert/4", nil)
+	result, err := tmdb.postTmdb(uri, body, &session)
+	return result.(*AuthenticationSession), err
+}
+
+// DeleteSession logs a user out by invalidating their session id
+// https://developers.themoviedb.org/3/authentication/delete-session
+func (tmdb *TMDb) DeleteSession(sessionID string) (*Status, error) {
+	body := struct {
+		SessionID string `json:"session_id"`
+	}{sessionID}
+	var status Status
+	uri := tmdb.buildURL("/authentication/session", nil)
+	result, err := tmdb.deleteTmdb(uri, body, &status)
+	return result.(*Status), err
+}
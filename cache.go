@@ -0,0 +1,316 @@
+package tmdb
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Cache stores raw response bodies of successful GET requests. Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored under key, if it has not expired
+	Get(key string) ([]byte, bool)
+	// Set stores value under key for ttl
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// DefaultCacheTTLs holds how long responses are cached, keyed by endpoint
+// family. A family is either the first path segment ("movie" for /movie/550)
+// or the first two ("movie/popular"), the latter taking precedence.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"configuration":     24 * time.Hour,
+	"genre":             24 * time.Hour,
+	"certification":     24 * time.Hour,
+	"timezones":         24 * time.Hour,
+	"jobs":              24 * time.Hour,
+	"collection":        6 * time.Hour,
+	"company":           6 * time.Hour,
+	"credit":            6 * time.Hour,
+	"find":              6 * time.Hour,
+	"keyword":           6 * time.Hour,
+	"movie":             6 * time.Hour,
+	"network":           6 * time.Hour,
+	"person":            6 * time.Hour,
+	"review":            6 * time.Hour,
+	"tv":                6 * time.Hour,
+	"watch":             24 * time.Hour,
+	"movie/latest":      15 * time.Minute,
+	"movie/now_playing": time.Hour,
+	"movie/popular":     time.Hour,
+	"movie/top_rated":   time.Hour,
+	"movie/upcoming":    time.Hour,
+	"movie/changes":     15 * time.Minute,
+	"person/latest":     15 * time.Minute,
+	"person/popular":    time.Hour,
+	"person/changes":    15 * time.Minute,
+	"tv/latest":         15 * time.Minute,
+	"tv/airing_today":   time.Hour,
+	"tv/on_the_air":     time.Hour,
+	"tv/popular":        time.Hour,
+	"tv/top_rated":      time.Hour,
+	"tv/changes":        15 * time.Minute,
+	"discover":          time.Hour,
+	"search":            time.Hour,
+	"trending":          15 * time.Minute,
+}
+
+// uncachedFamilies depend on the caller, or change through its own writes, and
+// are never cached or coalesced
+var uncachedFamilies = map[string]struct{}{
+	"account":        {},
+	"authentication": {},
+	"guest_session":  {},
+	"list":           {},
+}
+
+// CacheStats struct
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+}
+
+// responseCache is the cache configuration of a TMDb value, shared with its
+// WithContext copies
+type responseCache struct {
+	cache  Cache
+	ttls   map[string]time.Duration
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+func newResponseCache(cache Cache, overrides map[string]time.Duration) *responseCache {
+	ttls := make(map[string]time.Duration, len(DefaultCacheTTLs)+len(overrides))
+	for family, ttl := range DefaultCacheTTLs {
+		ttls[family] = ttl
+	}
+	for family, ttl := range overrides {
+		ttls[family] = ttl
+	}
+	return &responseCache{cache: cache, ttls: ttls}
+}
+
+// CacheStats returns the hit and miss counts of the response cache
+func (tmdb *TMDb) CacheStats() CacheStats {
+	if tmdb.cache == nil {
+		return CacheStats{}
+	}
+	return CacheStats{
+		Hits:   tmdb.cache.hits.Load(),
+		Misses: tmdb.cache.misses.Load(),
+	}
+}
+
+// cachedGet performs a GET request, serving it from the cache when possible
+func (tmdb *TMDb) cachedGet(uri string) (*response, error) {
+	if tmdb.cache == nil {
+		return tmdb.send(http.MethodGet, uri, nil)
+	}
+	key, ttl := tmdb.cache.entry(tmdb.baseURL, uri)
+	if ttl <= 0 {
+		return tmdb.send(http.MethodGet, uri, nil)
+	}
+
+	if body, ok := tmdb.cache.cache.Get(key); ok {
+		tmdb.cache.hits.Add(1)
+		return &response{statusCode: http.StatusOK, body: body}, nil
+	}
+	tmdb.cache.misses.Add(1)
+
+	res, err := tmdb.send(http.MethodGet, uri, nil)
+	if err == nil && res.statusCode >= 200 && res.statusCode < 300 {
+		tmdb.cache.cache.Set(key, res.body, ttl)
+	}
+	return res, err
+}
+
+// entry returns the cache key and TTL of a request URL. Credentials are left
+// out of the key, and a TTL of zero means the response must not be cached.
+func (c *responseCache) entry(baseURL, uri string) (string, time.Duration) {
+	if isPrivateRequest(baseURL, uri) {
+		return "", 0
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return "", 0
+	}
+	query := parsed.Query()
+	query.Del("api_key")
+
+	segments := pathSegments(baseURL, uri)
+	ttl, ok := time.Duration(0), false
+	if len(segments) > 1 {
+		ttl, ok = c.ttls[segments[0]+"/"+segments[1]]
+	}
+	if !ok {
+		ttl = c.ttls[segments[0]]
+	}
+
+	parsed.RawQuery = query.Encode()
+	return parsed.String(), ttl
+}
+
+// isPrivateRequest reports whether the response to a request URL belongs to
+// its caller, so it must be neither cached nor shared with other callers
+func isPrivateRequest(baseURL, uri string) bool {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return true
+	}
+	query := parsed.Query()
+	if query.Has("session_id") || query.Has("guest_session_id") {
+		return true
+	}
+	_, ok := uncachedFamilies[pathSegments(baseURL, uri)[0]]
+	return ok
+}
+
+// pathSegments splits the path of a request URL relative to baseURL
+func pathSegments(baseURL, uri string) []string {
+	path := strings.TrimPrefix(uri, baseURL)
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	return strings.Split(strings.Trim(path, "/"), "/")
+}
+
+// MemoryCache is an in-memory Cache that evicts the least recently used
+// entries once it holds more than its capacity
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	entries  map[string]*list.Element
+}
+
+type memoryCacheEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates a MemoryCache holding up to capacity entries
+func NewMemoryCache(capacity int) *MemoryCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &MemoryCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Get implements Cache
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*memoryCacheEntry)
+	if time.Now().After(entry.expires) {
+		m.order.Remove(element)
+		delete(m.entries, key)
+		return nil, false
+	}
+	m.order.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set implements Cache
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if element, ok := m.entries[key]; ok {
+		entry := element.Value.(*memoryCacheEntry)
+		entry.value = value
+		entry.expires = expires
+		m.order.MoveToFront(element)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryCacheEntry{key: key, value: value, expires: expires})
+	for m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheEntry).key)
+	}
+}
+
+// Len returns the number of entries held, expired ones included
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+// DiskCache is a Cache keeping one file per entry in a directory, so cached
+// responses survive restarts
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache creates a DiskCache in dir, creating the directory if needed
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:]))
+}
+
+// Get implements Cache
+func (d *DiskCache) Get(key string) ([]byte, bool) {
+	path := d.path(key)
+	data, err := os.ReadFile(path)
+	if err != nil || len(data) < 8 {
+		return nil, false
+	}
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(data[:8])))
+	if time.Now().After(expires) {
+		os.Remove(path)
+		return nil, false
+	}
+	return data[8:], true
+}
+
+// Set implements Cache. Entries are written to a temporary file first, so
+// concurrent readers never see a partial entry.
+func (d *DiskCache) Set(key string, value []byte, ttl time.Duration) {
+	data := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(data[:8], uint64(time.Now().Add(ttl).UnixNano()))
+	copy(data[8:], value)
+
+	tmp, err := os.CreateTemp(d.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), d.path(key))
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+	}
+}
@@ -0,0 +1,151 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// newCountingServer answers every request with body and counts the hits
+func newCountingServer(body string) (*httptest.Server, *int32) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		fmt.Fprint(w, body)
+	}))
+	return server, &hits
+}
+
+func (s *LocalSuite) TestCacheServesRepeatedRequests(c *C) {
+	server, hits := newCountingServer(`{"genres": [{"id": 28, "name": "Action"}]}`)
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, Cache: NewMemoryCache(10)})
+
+	for i := 0; i < 3; i++ {
+		genres, err := api.GetMovieGenres(nil)
+		c.Assert(err, IsNil)
+		c.Assert(genres.Genres, HasLen, 1)
+	}
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(1))
+	c.Assert(api.CacheStats(), Equals, CacheStats{Hits: 2, Misses: 1})
+
+	// A different query is a different entry
+	_, err := api.GetMovieGenres(map[string]string{"language": "es"})
+	c.Assert(err, IsNil)
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(2))
+}
+
+func (s *LocalSuite) TestCacheSkipsSessionsAndFailures(c *C) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.URL.Path == "/movie/0" {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"status_code": 34, "status_message": "Not found"}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 550}`)
+	}))
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, Cache: NewMemoryCache(10)})
+
+	for i := 0; i < 2; i++ {
+		_, err := api.GetAccountInfo("session")
+		c.Assert(err, IsNil)
+		_, err = api.GetMovieAccountStates(550, "session")
+		c.Assert(err, IsNil)
+		_, err = api.GetMovieInfo(0, nil)
+		c.Assert(err, NotNil)
+	}
+	c.Assert(atomic.LoadInt32(&hits), Equals, int32(6))
+}
+
+func (s *LocalSuite) TestCacheTTLOverrides(c *C) {
+	server, hits := newCountingServer(`{"id": 550}`)
+	defer server.Close()
+	api := Init(Config{
+		APIKey:    "key",
+		BaseURL:   server.URL,
+		Cache:     NewMemoryCache(10),
+		CacheTTLs: map[string]time.Duration{"movie": 0},
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := api.GetMovieInfo(550, nil)
+		c.Assert(err, IsNil)
+	}
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(2))
+	c.Assert(api.CacheStats(), Equals, CacheStats{})
+}
+
+func (s *LocalSuite) TestCacheEntry(c *C) {
+	cache := newResponseCache(NewMemoryCache(1), nil)
+	base := "https://api.themoviedb.org/3"
+	tests := []struct {
+		uri string
+		key string
+		ttl time.Duration
+	}{
+		{base + "/configuration?api_key=secret", base + "/configuration", 24 * time.Hour},
+		{base + "/movie/550?api_key=secret&language=es", base + "/movie/550?language=es", 6 * time.Hour},
+		{base + "/movie/popular?page=2&api_key=secret", base + "/movie/popular?page=2", time.Hour},
+		{base + "/trending/all/day?api_key=secret", base + "/trending/all/day", 15 * time.Minute},
+		{base + "/account?api_key=secret", "", 0},
+		{base + "/movie/550/account_states?api_key=secret&session_id=abc", "", 0},
+	}
+	for _, test := range tests {
+		key, ttl := cache.entry(base, test.uri)
+		c.Check(ttl, Equals, test.ttl, Commentf(test.uri))
+		if test.ttl > 0 {
+			c.Check(key, Equals, test.key)
+		}
+	}
+}
+
+func (s *LocalSuite) TestMemoryCacheEvictsLeastRecentlyUsed(c *C) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", []byte("1"), time.Hour)
+	cache.Set("b", []byte("2"), time.Hour)
+	_, ok := cache.Get("a")
+	c.Assert(ok, Equals, true)
+	cache.Set("c", []byte("3"), time.Hour)
+
+	_, ok = cache.Get("b")
+	c.Assert(ok, Equals, false)
+	value, ok := cache.Get("a")
+	c.Assert(ok, Equals, true)
+	c.Assert(string(value), Equals, "1")
+	c.Assert(cache.Len(), Equals, 2)
+}
+
+func (s *LocalSuite) TestMemoryCacheExpires(c *C) {
+	cache := NewMemoryCache(2)
+	cache.Set("a", []byte("1"), -time.Second)
+	_, ok := cache.Get("a")
+	c.Assert(ok, Equals, false)
+	c.Assert(cache.Len(), Equals, 0)
+}
+
+func (s *LocalSuite) TestDiskCache(c *C) {
+	dir := c.MkDir()
+	cache, err := NewDiskCache(dir)
+	c.Assert(err, IsNil)
+
+	cache.Set("fresh", []byte(`{"id": 550}`), time.Hour)
+	cache.Set("stale", []byte(`{"id": 551}`), -time.Second)
+
+	// A new value over the same directory sees the entries
+	reopened, err := NewDiskCache(dir)
+	c.Assert(err, IsNil)
+	value, ok := reopened.Get("fresh")
+	c.Assert(ok, Equals, true)
+	c.Assert(string(value), Equals, `{"id": 550}`)
+	_, ok = reopened.Get("stale")
+	c.Assert(ok, Equals, false)
+	_, ok = reopened.Get("missing")
+	c.Assert(ok, Equals, false)
+}
@@ -1,9 +1,5 @@
 package tmdb
 
-import (
-	"fmt"
-)
-
 // Certification struct
 type Certification struct {
 	Certifications map[string][]struct {
@@ -17,8 +13,8 @@ type Certification struct {
 // https://developers.themoviedb.org/3/certifications/get-movie-certifications
 func (tmdb *TMDb) GetCertificationsMovieList() (*Certification, error) {
 	var movieCert Certification
-	uri := fmt.Sprintf("%s/certification/movie/list?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &movieCert)
+	uri := tmdb.buildURL("/certification/movie/list", nil)
+	result, err := tmdb.getTmdb(uri, &movieCert)
 	return result.(*Certification), err
 }
 
@@ -26,7 +22,7 @@ func (tmdb *TMDb) GetCertificationsMovieList() (*Certification, error) {
 // https://developers.themoviedb.org/3/certifications/get-tv-certifications
 func (tmdb *TMDb) GetCertificationsTvList() (*Certification, error) {
 	var tvCert Certification
-	uri := fmt.Sprintf("%s/certification/tv/list?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &tvCert)
+	uri := tmdb.buildURL("/certification/tv/list", nil)
+	result, err := tmdb.getTmdb(uri, &tvCert)
 	return result.(*Certification), err
 }
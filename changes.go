@@ -1,9 +1,5 @@
 package tmdb
 
-import (
-	"fmt"
-)
-
 // Changes struct
 type Changes struct {
 	Results []struct {
@@ -21,9 +17,12 @@ var changeOptions = map[string]struct{}{
 // https://developers.themoviedb.org/3/changes/get-movie-change-list
 func (tmdb *TMDb) GetChangesMovie(options map[string]string) (*Changes, error) {
 	var movieChanges Changes
-	optionsString := getOptionsString(options, changeOptions)
-	uri := fmt.Sprintf("%s/movie/changes?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &movieChanges)
+	query, err := getOptions(options, changeOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/movie/changes", query)
+	result, err := tmdb.getTmdb(uri, &movieChanges)
 	return result.(*Changes), err
 }
 
@@ -31,9 +30,12 @@ func (tmdb *TMDb) GetChangesMovie(options map[string]string) (*Changes, error) {
 // https://developers.themoviedb.org/3/changes/get-person-change-list
 func (tmdb *TMDb) GetChangesPerson(options map[string]string) (*Changes, error) {
 	var personChanges Changes
-	optionsString := getOptionsString(options, changeOptions)
-	uri := fmt.Sprintf("%s/person/changes?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &personChanges)
+	query, err := getOptions(options, changeOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/person/changes", query)
+	result, err := tmdb.getTmdb(uri, &personChanges)
 	return result.(*Changes), err
 }
 
@@ -41,8 +43,11 @@ func (tmdb *TMDb) GetChangesPerson(options map[string]string) (*Changes, error)
 // https://developers.themoviedb.org/3/changes/get-tv-change-list
 func (tmdb *TMDb) GetChangesTv(options map[string]string) (*Changes, error) {
 	var tvChanges Changes
-	optionsString := getOptionsString(options, changeOptions)
-	uri := fmt.Sprintf("%s/tv/changes?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &tvChanges)
+	query, err := getOptions(options, changeOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/tv/changes", query)
+	result, err := tmdb.getTmdb(uri, &tvChanges)
 	return result.(*Changes), err
 }
@@ -0,0 +1,63 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// inflightGroup lets concurrent identical GET requests share one upstream
+// response. It is shared by a TMDb value and its WithContext copies. Requests
+// private to their caller, such as new tokens and sessions, are never shared.
+type inflightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*inflightCall
+}
+
+type inflightCall struct {
+	done chan struct{}
+	res  *response
+	err  error
+}
+
+func newInflightGroup() *inflightGroup {
+	return &inflightGroup{calls: make(map[string]*inflightCall)}
+}
+
+// do runs fn once for all concurrent callers using the same key. Callers
+// waiting on another one's request still honor their own ctx, and start over
+// if that request was cut short by its caller's context.
+func (g *inflightGroup) do(ctx context.Context, key string, fn func() (*response, error)) (*response, error) {
+	for {
+		g.mu.Lock()
+		call, ok := g.calls[key]
+		if !ok {
+			call = &inflightCall{done: make(chan struct{})}
+			g.calls[key] = call
+			g.mu.Unlock()
+
+			call.res, call.err = fn()
+
+			g.mu.Lock()
+			delete(g.calls, key)
+			g.mu.Unlock()
+			close(call.done)
+			return call.res, call.err
+		}
+		g.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-call.done:
+		}
+		if isContextError(call.err) && ctx.Err() == nil {
+			continue
+		}
+		return call.res, call.err
+	}
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
@@ -0,0 +1,165 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// newGatedServer holds every request until release is closed
+func newGatedServer(release chan struct{}) (*httptest.Server, *int32) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		<-release
+		fmt.Fprint(w, `{"id": 550, "title": "Fight Club"}`)
+	}))
+	return server, &hits
+}
+
+func (s *LocalSuite) TestCoalescingSharesOneUpstreamRequest(c *C) {
+	release := make(chan struct{})
+	server, hits := newGatedServer(release)
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			movie, err := api.GetMovieInfo(550, nil)
+			c.Check(err, IsNil)
+			c.Check(movie.Title, Equals, "Fight Club")
+		}()
+	}
+	// Give every goroutine time to join the in-flight request
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(1))
+}
+
+func (s *LocalSuite) TestCoalescingCanBeDisabled(c *C) {
+	release := make(chan struct{})
+	server, hits := newGatedServer(release)
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, DisableCoalescing: true})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := api.GetMovieInfo(550, nil)
+			c.Check(err, IsNil)
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(5))
+}
+
+func (s *LocalSuite) TestCoalescingSkipsAuthentication(c *C) {
+	release := make(chan struct{})
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&hits, 1)
+		<-release
+		fmt.Fprintf(w, `{"success": true, "request_token": "t%d", "guest_session_id": "g%d"}`, n, n)
+	}))
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL})
+
+	var mu sync.Mutex
+	ids := make(map[string]int)
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			token, err := api.GetAuthToken()
+			c.Check(err, IsNil)
+			mu.Lock()
+			ids[token.RequestToken]++
+			mu.Unlock()
+		}()
+		go func() {
+			defer wg.Done()
+			session, err := api.GetAuthGuestSession()
+			c.Check(err, IsNil)
+			mu.Lock()
+			ids[session.GuestSessionID]++
+			mu.Unlock()
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	c.Assert(atomic.LoadInt32(&hits), Equals, int32(10))
+	c.Assert(ids, HasLen, 10)
+}
+
+func (s *LocalSuite) TestCoalescingWaiterOutlivesCancelledLeader(c *C) {
+	group := newInflightGroup()
+	started := make(chan struct{})
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+
+	leaderDone := make(chan error)
+	go func() {
+		_, err := group.do(leaderCtx, "key", func() (*response, error) {
+			close(started)
+			<-leaderCtx.Done()
+			return nil, leaderCtx.Err()
+		})
+		leaderDone <- err
+	}()
+	<-started
+
+	waiterDone := make(chan *response)
+	go func() {
+		res, err := group.do(context.Background(), "key", func() (*response, error) {
+			return &response{statusCode: http.StatusOK}, nil
+		})
+		c.Check(err, IsNil)
+		waiterDone <- res
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	cancelLeader()
+	c.Assert(errors.Is(<-leaderDone, context.Canceled), Equals, true)
+	c.Assert((<-waiterDone).statusCode, Equals, http.StatusOK)
+}
+
+func (s *LocalSuite) TestCoalescingWaiterHonorsItsContext(c *C) {
+	group := newInflightGroup()
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+
+	go group.do(context.Background(), "key", func() (*response, error) {
+		close(started)
+		<-release
+		return &response{statusCode: http.StatusOK}, nil
+	})
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := group.do(ctx, "key", func() (*response, error) {
+		c.Fatalf("waiter must not start its own request")
+		return nil, nil
+	})
+	c.Assert(errors.Is(err, context.DeadlineExceeded), Equals, true)
+}
@@ -53,9 +53,12 @@ func (tmdb *TMDb) GetCollectionInfo(id int, options map[string]string) (*Collect
 		"language":           {},
 		"append_to_response": {}}
 	var collection Collection
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/collection/%v?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &collection)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/collection/%v", id), query)
+	result, err := tmdb.getTmdb(uri, &collection)
 	return result.(*Collection), err
 }
 
@@ -67,8 +70,11 @@ func (tmdb *TMDb) GetCollectionImages(id int, options map[string]string) (*Colle
 		"append_to_response":     {},
 		"include_image_language": {}}
 	var images CollectionImages
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/collection/%v/images?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &images)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/collection/%v/images", id), query)
+	result, err := tmdb.getTmdb(uri, &images)
 	return result.(*CollectionImages), err
 }
@@ -26,9 +26,12 @@ func (tmdb *TMDb) GetCompanyInfo(id int, options map[string]string) (*Company, e
 	var availableOptions = map[string]struct{}{
 		"append_to_response": {}}
 	var companyInfo Company
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/company/%v?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &companyInfo)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/company/%v", id), query)
+	result, err := tmdb.getTmdb(uri, &companyInfo)
 	return result.(*Company), err
 }
 
@@ -40,8 +43,11 @@ func (tmdb *TMDb) GetCompanyMovies(id int, options map[string]string) (*CompanyM
 		"language":           {},
 		"append_to_response": {}}
 	var movies CompanyMoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/company/%v/movies?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &movies)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/company/%v/movies", id), query)
+	result, err := tmdb.getTmdb(uri, &movies)
 	return result.(*CompanyMoviePagedResults), err
 }
@@ -1,9 +1,5 @@
 package tmdb
 
-import (
-	"fmt"
-)
-
 // Configuration struct
 type Configuration struct {
 	Images struct {
@@ -22,10 +18,14 @@ type Configuration struct {
 // https://developers.themoviedb.org/3/configuration/get-api-configuration
 func (tmdb *TMDb) GetConfiguration() (*Configuration, error) {
 	var config Configuration
-	uri := fmt.Sprintf("%s/configuration?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &config)
+	uri := tmdb.buildURL("/configuration", nil)
+	result, err := tmdb.getTmdb(uri, &config)
 	if err != nil {
 		return nil, err
 	}
+	if tmdb.imageBaseURL != "" {
+		config.Images.BaseURL = tmdb.imageBaseURL
+		config.Images.SecureBaseURL = tmdb.imageBaseURL
+	}
 	return result.(*Configuration), err
 }
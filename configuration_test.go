@@ -1,6 +1,10 @@
 package tmdb
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
 	. "gopkg.in/check.v1"
 )
 
@@ -12,3 +16,42 @@ func (s *TmdbSuite) TestGetConfiguration(c *C) {
 	c.Assert(result.Images.BackdropSizes, HasLen, 4)
 	c.Assert(result.ChangeKeys, HasLen, 53)
 }
+
+const configurationFixture = `{
+  "images": {
+    "base_url": "http://image.tmdb.org/t/p/",
+    "secure_base_url": "https://image.tmdb.org/t/p/",
+    "backdrop_sizes": ["w300", "w780", "w1280", "original"],
+    "logo_sizes": ["w45", "w92", "w154", "w185", "w300", "w500", "original"],
+    "poster_sizes": ["w92", "w154", "w185", "w342", "w500", "w780", "original"],
+    "profile_sizes": ["w45", "w185", "h632", "original"],
+    "still_sizes": ["w92", "w185", "w300", "original"]
+  },
+  "change_keys": ["adult", "air_date", "also_known_as"]
+}`
+
+func newConfigurationServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/configuration" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, configurationFixture)
+	}))
+}
+
+func (s *LocalSuite) TestGetConfigurationImageBaseURL(c *C) {
+	server := newConfigurationServer()
+	defer server.Close()
+
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, ImageBaseURL: "https://images.mirror.local/t/p"})
+	result, err := api.GetConfiguration()
+	c.Assert(err, IsNil)
+	c.Assert(result.Images.BaseURL, Equals, "https://images.mirror.local/t/p/")
+	c.Assert(result.Images.SecureBaseURL, Equals, "https://images.mirror.local/t/p/")
+
+	api = Init(Config{APIKey: "key", BaseURL: server.URL})
+	result, err = api.GetConfiguration()
+	c.Assert(err, IsNil)
+	c.Assert(result.Images.SecureBaseURL, Equals, "https://image.tmdb.org/t/p/")
+}
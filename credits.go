@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // Credit struct
@@ -42,8 +43,11 @@ func (tmdb *TMDb) GetCreditInfo(id string, options map[string]string) (*Credit,
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var creditInfo Credit
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/credit/%v?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &creditInfo)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/credit/%v", url.PathEscape(id)), query)
+	result, err := tmdb.getTmdb(uri, &creditInfo)
 	return result.(*Credit), err
 }
@@ -1,41 +1,61 @@
 package tmdb
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // DiscoverMovie discovers movies by different types of data like average rating, number of votes, genres and certifications
 // https://developers.themoviedb.org/3/discover/movie-discover
 func (tmdb *TMDb) DiscoverMovie(options map[string]string) (*MoviePagedResults, error) {
 	var availableOptions = map[string]struct{}{
-		"certification_country":    {},
-		"certification":            {},
-		"certification.lte":        {},
-		"include_adult":            {},
-		"include_video":            {},
-		"language":                 {},
-		"page":                     {},
-		"primary_release_year":     {},
-		"primary_release_date.gte": {},
-		"primary_release_date.lte": {},
-		"release_date.gte":         {},
-		"release_date.lte":         {},
-		"sort_by":                  {},
-		"vote_count.gte":           {},
-		"vote_count.lte":           {},
-		"vote_average.gte":         {},
-		"vote_average.lte":         {},
-		"with_cast":                {},
-		"with_crew":                {},
-		"with_companies":           {},
-		"with_genres":              {},
-		"with_keywords":            {},
-		"with_people":              {},
-		"year":                     {}}
-	optionsString := getOptionsString(options, availableOptions)
+		"certification_country":         {},
+		"certification":                 {},
+		"certification.gte":             {},
+		"certification.lte":             {},
+		"include_adult":                 {},
+		"include_video":                 {},
+		"language":                      {},
+		"page":                          {},
+		"primary_release_year":          {},
+		"primary_release_date.gte":      {},
+		"primary_release_date.lte":      {},
+		"region":                        {},
+		"release_date.gte":              {},
+		"release_date.lte":              {},
+		"sort_by":                       {},
+		"vote_count.gte":                {},
+		"vote_count.lte":                {},
+		"vote_average.gte":              {},
+		"vote_average.lte":              {},
+		"watch_region":                  {},
+		"with_cast":                     {},
+		"with_crew":                     {},
+		"with_companies":                {},
+		"with_genres":                   {},
+		"with_keywords":                 {},
+		"with_origin_country":           {},
+		"with_original_language":        {},
+		"with_people":                   {},
+		"with_release_type":             {},
+		"with_runtime.gte":              {},
+		"with_runtime.lte":              {},
+		"with_watch_monetization_types": {},
+		"with_watch_providers":          {},
+		"without_companies":             {},
+		"without_genres":                {},
+		"without_keywords":              {},
+		"year":                          {}}
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
 	var results MoviePagedResults
-	uri := fmt.Sprintf("%s/discover/movie?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &results)
+	uri := tmdb.buildURL("/discover/movie", query)
+	result, err := tmdb.getTmdb(uri, &results)
 	return result.(*MoviePagedResults), err
 }
 
@@ -43,19 +63,644 @@ func (tmdb *TMDb) DiscoverMovie(options map[string]string) (*MoviePagedResults,
 // https://developers.themoviedb.org/3/discover/tv-discover
 func (tmdb *TMDb) DiscoverTV(options map[string]string) (*TvPagedResults, error) {
 	var availableOptions = map[string]struct{}{
-		"page":                {},
-		"language":            {},
-		"sort_by":             {},
-		"first_air_date_year": {},
-		"first_air_date.gte":  {},
-		"first_air_date.lte":  {},
-		"vote_count.gte":      {},
-		"vote_average.gte":    {},
-		"with_genres":         {},
-		"with_networks":       {}}
-	optionsString := getOptionsString(options, availableOptions)
+		"page":                          {},
+		"language":                      {},
+		"sort_by":                       {},
+		"air_date.gte":                  {},
+		"air_date.lte":                  {},
+		"first_air_date_year":           {},
+		"first_air_date.gte":            {},
+		"first_air_date.lte":            {},
+		"include_adult":                 {},
+		"include_null_first_air_dates":  {},
+		"screened_theatrically":         {},
+		"timezone":                      {},
+		"vote_count.gte":                {},
+		"vote_average.gte":              {},
+		"vote_average.lte":              {},
+		"watch_region":                  {},
+		"with_companies":                {},
+		"with_genres":                   {},
+		"with_keywords":                 {},
+		"with_networks":                 {},
+		"with_origin_country":           {},
+		"with_original_language":        {},
+		"with_runtime.gte":              {},
+		"with_runtime.lte":              {},
+		"with_watch_monetization_types": {},
+		"with_watch_providers":          {},
+		"without_companies":             {},
+		"without_genres":                {},
+		"without_keywords":              {}}
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
 	var results TvPagedResults
-	uri := fmt.Sprintf("%s/discover/tv?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &results)
+	uri := tmdb.buildURL("/discover/tv", query)
+	result, err := tmdb.getTmdb(uri, &results)
 	return result.(*TvPagedResults), err
 }
+
+// DiscoverMovieWith discovers movies matching q
+func (tmdb *TMDb) DiscoverMovieWith(q *DiscoverMovieQuery) (*MoviePagedResults, error) {
+	options, err := q.Options()
+	if err != nil {
+		return nil, err
+	}
+	return tmdb.DiscoverMovie(options)
+}
+
+// DiscoverTVWith discovers TV shows matching q
+func (tmdb *TMDb) DiscoverTVWith(q *DiscoverTVQuery) (*TvPagedResults, error) {
+	options, err := q.Options()
+	if err != nil {
+		return nil, err
+	}
+	return tmdb.DiscoverTV(options)
+}
+
+// ErrInvalidDiscoverQuery is wrapped by the errors of invalid discover queries
+var ErrInvalidDiscoverQuery = errors.New("invalid discover query")
+
+// SortOrder type
+type SortOrder string
+
+// Sort orders
+const (
+	Ascending  SortOrder = "asc"
+	Descending SortOrder = "desc"
+)
+
+// MovieSortField type
+type MovieSortField string
+
+// Sort fields of DiscoverMovieQuery
+const (
+	MovieSortOriginalTitle      MovieSortField = "original_title"
+	MovieSortPopularity         MovieSortField = "popularity"
+	MovieSortPrimaryReleaseDate MovieSortField = "primary_release_date"
+	MovieSortRevenue            MovieSortField = "revenue"
+	MovieSortTitle              MovieSortField = "title"
+	MovieSortVoteAverage        MovieSortField = "vote_average"
+	MovieSortVoteCount          MovieSortField = "vote_count"
+)
+
+// TvSortField type
+type TvSortField string
+
+// Sort fields of DiscoverTVQuery
+const (
+	TvSortFirstAirDate TvSortField = "first_air_date"
+	TvSortName         TvSortField = "name"
+	TvSortOriginalName TvSortField = "original_name"
+	TvSortPopularity   TvSortField = "popularity"
+	TvSortVoteAverage  TvSortField = "vote_average"
+	TvSortVoteCount    TvSortField = "vote_count"
+)
+
+// MonetizationType type
+type MonetizationType string
+
+// Ways a title can be offered by a watch provider
+const (
+	MonetizationFlatrate MonetizationType = "flatrate"
+	MonetizationFree     MonetizationType = "free"
+	MonetizationAds      MonetizationType = "ads"
+	MonetizationRent     MonetizationType = "rent"
+	MonetizationBuy      MonetizationType = "buy"
+)
+
+// IDFilter combines ids for the with_* and without_* discover filters
+type IDFilter struct {
+	ids       []int
+	separator string
+}
+
+// AllOf matches results having every one of ids
+func AllOf(ids ...int) IDFilter {
+	return IDFilter{ids: ids, separator: ","}
+}
+
+// AnyOf matches results having at least one of ids
+func AnyOf(ids ...int) IDFilter {
+	return IDFilter{ids: ids, separator: "|"}
+}
+
+func (f IDFilter) String() string {
+	ids := make([]string, len(f.ids))
+	for i, id := range f.ids {
+		ids[i] = strconv.Itoa(id)
+	}
+	return strings.Join(ids, f.separator)
+}
+
+// discoverQuery holds what DiscoverMovieQuery and DiscoverTVQuery have in
+// common. The first validation error is kept and reported by Options.
+type discoverQuery struct {
+	options map[string]string
+	err     error
+}
+
+func (q *discoverQuery) set(key, value string) {
+	if q.options == nil {
+		q.options = make(map[string]string)
+	}
+	q.options[key] = value
+}
+
+func (q *discoverQuery) fail(format string, args ...interface{}) {
+	if q.err == nil {
+		q.err = fmt.Errorf("%w: %s", ErrInvalidDiscoverQuery, fmt.Sprintf(format, args...))
+	}
+}
+
+func (q *discoverQuery) page(page int) {
+	if page < 1 || page > MaxPage {
+		q.fail("page %d out of range [1, %d]", page, MaxPage)
+		return
+	}
+	q.set("page", strconv.Itoa(page))
+}
+
+func (q *discoverQuery) sortBy(field string, known bool, order SortOrder) {
+	if !known {
+		q.fail("unknown sort field %q", field)
+		return
+	}
+	if order != Ascending && order != Descending {
+		q.fail("unknown sort order %q", order)
+		return
+	}
+	q.set("sort_by", field+"."+string(order))
+}
+
+func (q *discoverQuery) ids(key string, filter IDFilter) {
+	if len(filter.ids) == 0 {
+		q.fail("%s needs at least one id", key)
+		return
+	}
+	q.set(key, filter.String())
+}
+
+func (q *discoverQuery) dateRange(key string, from, to time.Time) {
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		q.fail("%s range starts after it ends", key)
+		return
+	}
+	if !from.IsZero() {
+		q.set(key+".gte", from.Format(dateFormat))
+	}
+	if !to.IsZero() {
+		q.set(key+".lte", to.Format(dateFormat))
+	}
+}
+
+func (q *discoverQuery) intRange(key string, min, max int) {
+	if min < 0 || max < 0 || (max > 0 && min > max) {
+		q.fail("invalid %s range [%d, %d]", key, min, max)
+		return
+	}
+	if min > 0 {
+		q.set(key+".gte", strconv.Itoa(min))
+	}
+	if max > 0 {
+		q.set(key+".lte", strconv.Itoa(max))
+	}
+}
+
+func (q *discoverQuery) voteAverage(min, max float64) {
+	if min < 0 || min > 10 || max > 10 || (max > 0 && min > max) {
+		q.fail("invalid vote_average range [%g, %g]", min, max)
+		return
+	}
+	if min > 0 {
+		q.set("vote_average.gte", strconv.FormatFloat(min, 'f', -1, 64))
+	}
+	if max > 0 {
+		q.set("vote_average.lte", strconv.FormatFloat(max, 'f', -1, 64))
+	}
+}
+
+func (q *discoverQuery) watchProviders(region string, providers IDFilter, types []MonetizationType) {
+	if region == "" {
+		q.fail("watch providers need a region")
+		return
+	}
+	q.set("watch_region", region)
+	q.ids("with_watch_providers", providers)
+	if len(types) == 0 {
+		return
+	}
+	names := make([]string, len(types))
+	for i, t := range types {
+		switch t {
+		case MonetizationFlatrate, MonetizationFree, MonetizationAds, MonetizationRent, MonetizationBuy:
+		default:
+			q.fail("unknown monetization type %q", t)
+			return
+		}
+		names[i] = string(t)
+	}
+	q.set("with_watch_monetization_types", strings.Join(names, "|"))
+}
+
+func (q *discoverQuery) result() (map[string]string, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	options := make(map[string]string, len(q.options))
+	for key, value := range q.options {
+		options[key] = value
+	}
+	return options, nil
+}
+
+// DiscoverMovieQuery builds the options of DiscoverMovie:
+//
+//	q := tmdb.NewDiscoverMovieQuery().
+//		WithGenres(tmdb.AnyOf(28, 12)).
+//		PrimaryReleaseDate(from, to).
+//		SortBy(tmdb.MovieSortPopularity, tmdb.Descending)
+//	movies, err := api.DiscoverMovieWith(q)
+type DiscoverMovieQuery struct {
+	discoverQuery
+}
+
+// NewDiscoverMovieQuery returns an empty DiscoverMovieQuery
+func NewDiscoverMovieQuery() *DiscoverMovieQuery {
+	return &DiscoverMovieQuery{}
+}
+
+// Options returns the options to pass to DiscoverMovie, or the first
+// validation error met while building the query
+func (q *DiscoverMovieQuery) Options() (map[string]string, error) {
+	return q.result()
+}
+
+// Language sets the language of the results
+func (q *DiscoverMovieQuery) Language(language string) *DiscoverMovieQuery {
+	q.set("language", language)
+	return q
+}
+
+// Page selects the page of results
+func (q *DiscoverMovieQuery) Page(page int) *DiscoverMovieQuery {
+	q.page(page)
+	return q
+}
+
+// Region restricts release dates to an ISO 3166-1 country
+func (q *DiscoverMovieQuery) Region(region string) *DiscoverMovieQuery {
+	q.set("region", region)
+	return q
+}
+
+// SortBy sets the sort order of the results
+func (q *DiscoverMovieQuery) SortBy(field MovieSortField, order SortOrder) *DiscoverMovieQuery {
+	switch field {
+	case MovieSortOriginalTitle, MovieSortPopularity, MovieSortPrimaryReleaseDate,
+		MovieSortRevenue, MovieSortTitle, MovieSortVoteAverage, MovieSortVoteCount:
+		q.sortBy(string(field), true, order)
+	default:
+		q.sortBy(string(field), false, order)
+	}
+	return q
+}
+
+// IncludeAdult includes or excludes adult movies
+func (q *DiscoverMovieQuery) IncludeAdult(include bool) *DiscoverMovieQuery {
+	q.set("include_adult", strconv.FormatBool(include))
+	return q
+}
+
+// IncludeVideo includes or excludes video releases
+func (q *DiscoverMovieQuery) IncludeVideo(include bool) *DiscoverMovieQuery {
+	q.set("include_video", strconv.FormatBool(include))
+	return q
+}
+
+// Certification matches movies certified exactly certification in country
+func (q *DiscoverMovieQuery) Certification(country, certification string) *DiscoverMovieQuery {
+	q.set("certification_country", country)
+	q.set("certification", certification)
+	return q
+}
+
+// CertificationRange matches movies certified between min and max in
+// country. Either bound may be empty.
+func (q *DiscoverMovieQuery) CertificationRange(country, min, max string) *DiscoverMovieQuery {
+	q.set("certification_country", country)
+	if min != "" {
+		q.set("certification.gte", min)
+	}
+	if max != "" {
+		q.set("certification.lte", max)
+	}
+	return q
+}
+
+// PrimaryReleaseYear matches movies first released in year
+func (q *DiscoverMovieQuery) PrimaryReleaseYear(year int) *DiscoverMovieQuery {
+	q.set("primary_release_year", strconv.Itoa(year))
+	return q
+}
+
+// Year matches movies with any release in year
+func (q *DiscoverMovieQuery) Year(year int) *DiscoverMovieQuery {
+	q.set("year", strconv.Itoa(year))
+	return q
+}
+
+// PrimaryReleaseDate matches movies first released between from and to.
+// A zero time leaves that bound open.
+func (q *DiscoverMovieQuery) PrimaryReleaseDate(from, to time.Time) *DiscoverMovieQuery {
+	q.dateRange("primary_release_date", from, to)
+	return q
+}
+
+// ReleaseDate matches movies with a release between from and to, in Region
+// when set. A zero time leaves that bound open.
+func (q *DiscoverMovieQuery) ReleaseDate(from, to time.Time) *DiscoverMovieQuery {
+	q.dateRange("release_date", from, to)
+	return q
+}
+
+// WithReleaseTypes matches movies with a release of any of types
+func (q *DiscoverMovieQuery) WithReleaseTypes(types ...ReleaseType) *DiscoverMovieQuery {
+	ids := make([]int, len(types))
+	for i, t := range types {
+		if t < ReleasePremiere || t > ReleaseTV {
+			q.fail("unknown release type %d", t)
+			return q
+		}
+		ids[i] = int(t)
+	}
+	q.ids("with_release_type", AnyOf(ids...))
+	return q
+}
+
+// VoteAverage matches movies rated between min and max, from 0 to 10. Zero
+// leaves that bound open.
+func (q *DiscoverMovieQuery) VoteAverage(min, max float64) *DiscoverMovieQuery {
+	q.voteAverage(min, max)
+	return q
+}
+
+// VoteCount matches movies with between min and max votes. Zero leaves that
+// bound open.
+func (q *DiscoverMovieQuery) VoteCount(min, max int) *DiscoverMovieQuery {
+	q.intRange("vote_count", min, max)
+	return q
+}
+
+// Runtime matches movies lasting between min and max minutes. Zero leaves
+// that bound open.
+func (q *DiscoverMovieQuery) Runtime(min, max int) *DiscoverMovieQuery {
+	q.intRange("with_runtime", min, max)
+	return q
+}
+
+// WithGenres matches movies by genre id
+func (q *DiscoverMovieQuery) WithGenres(filter IDFilter) *DiscoverMovieQuery {
+	q.ids("with_genres", filter)
+	return q
+}
+
+// WithoutGenres excludes movies by genre id
+func (q *DiscoverMovieQuery) WithoutGenres(ids ...int) *DiscoverMovieQuery {
+	q.ids("without_genres", AllOf(ids...))
+	return q
+}
+
+// WithKeywords matches movies by keyword id
+func (q *DiscoverMovieQuery) WithKeywords(filter IDFilter) *DiscoverMovieQuery {
+	q.ids("with_keywords", filter)
+	return q
+}
+
+// WithoutKeywords excludes movies by keyword id
+func (q *DiscoverMovieQuery) WithoutKeywords(ids ...int) *DiscoverMovieQuery {
+	q.ids("without_keywords", AllOf(ids...))
+	return q
+}
+
+// WithCompanies matches movies by production company id
+func (q *DiscoverMovieQuery) WithCompanies(filter IDFilter) *DiscoverMovieQuery {
+	q.ids("with_companies", filter)
+	return q
+}
+
+// WithoutCompanies excludes movies by production company id
+func (q *DiscoverMovieQuery) WithoutCompanies(ids ...int) *DiscoverMovieQuery {
+	q.ids("without_companies", AllOf(ids...))
+	return q
+}
+
+// WithCast matches movies by cast member id
+func (q *DiscoverMovieQuery) WithCast(filter IDFilter) *DiscoverMovieQuery {
+	q.ids("with_cast", filter)
+	return q
+}
+
+// WithCrew matches movies by crew member id
+func (q *DiscoverMovieQuery) WithCrew(filter IDFilter) *DiscoverMovieQuery {
+	q.ids("with_crew", filter)
+	return q
+}
+
+// WithPeople matches movies by cast or crew member id
+func (q *DiscoverMovieQuery) WithPeople(filter IDFilter) *DiscoverMovieQuery {
+	q.ids("with_people", filter)
+	return q
+}
+
+// WithOriginalLanguage matches movies by ISO 639-1 original language
+func (q *DiscoverMovieQuery) WithOriginalLanguage(language string) *DiscoverMovieQuery {
+	q.set("with_original_language", language)
+	return q
+}
+
+// WithOriginCountry matches movies by ISO 3166-1 origin country
+func (q *DiscoverMovieQuery) WithOriginCountry(country string) *DiscoverMovieQuery {
+	q.set("with_origin_country", country)
+	return q
+}
+
+// WithWatchProviders matches movies offered in region by the given providers,
+// optionally only through the given monetization types
+func (q *DiscoverMovieQuery) WithWatchProviders(region string, providers IDFilter, types ...MonetizationType) *DiscoverMovieQuery {
+	q.watchProviders(region, providers, types)
+	return q
+}
+
+// DiscoverTVQuery builds the options of DiscoverTV:
+//
+//	q := tmdb.NewDiscoverTVQuery().
+//		WithGenres(tmdb.AllOf(16)).
+//		WithOriginalLanguage("ja").
+//		SortBy(tmdb.TvSortFirstAirDate, tmdb.Descending)
+//	shows, err := api.DiscoverTVWith(q)
+type DiscoverTVQuery struct {
+	discoverQuery
+}
+
+// NewDiscoverTVQuery returns an empty DiscoverTVQuery
+func NewDiscoverTVQuery() *DiscoverTVQuery {
+	return &DiscoverTVQuery{}
+}
+
+// Options returns the options to pass to DiscoverTV, or the first
+// validation error met while building the query
+func (q *DiscoverTVQuery) Options() (map[string]string, error) {
+	return q.result()
+}
+
+// Language sets the language of the results
+func (q *DiscoverTVQuery) Language(language string) *DiscoverTVQuery {
+	q.set("language", language)
+	return q
+}
+
+// Page selects the page of results
+func (q *DiscoverTVQuery) Page(page int) *DiscoverTVQuery {
+	q.page(page)
+	return q
+}
+
+// Timezone sets the timezone used by AirDate
+func (q *DiscoverTVQuery) Timezone(timezone string) *DiscoverTVQuery {
+	q.set("timezone", timezone)
+	return q
+}
+
+// SortBy sets the sort order of the results
+func (q *DiscoverTVQuery) SortBy(field TvSortField, order SortOrder) *DiscoverTVQuery {
+	switch field {
+	case TvSortFirstAirDate, TvSortName, TvSortOriginalName,
+		TvSortPopularity, TvSortVoteAverage, TvSortVoteCount:
+		q.sortBy(string(field), true, order)
+	default:
+		q.sortBy(string(field), false, order)
+	}
+	return q
+}
+
+// IncludeAdult includes or excludes adult shows
+func (q *DiscoverTVQuery) IncludeAdult(include bool) *DiscoverTVQuery {
+	q.set("include_adult", strconv.FormatBool(include))
+	return q
+}
+
+// IncludeNullFirstAirDates includes shows without a first air date
+func (q *DiscoverTVQuery) IncludeNullFirstAirDates(include bool) *DiscoverTVQuery {
+	q.set("include_null_first_air_dates", strconv.FormatBool(include))
+	return q
+}
+
+// ScreenedTheatrically matches shows with episodes released in theaters
+func (q *DiscoverTVQuery) ScreenedTheatrically(screened bool) *DiscoverTVQuery {
+	q.set("screened_theatrically", strconv.FormatBool(screened))
+	return q
+}
+
+// FirstAirDateYear matches shows whose first episode aired in year
+func (q *DiscoverTVQuery) FirstAirDateYear(year int) *DiscoverTVQuery {
+	q.set("first_air_date_year", strconv.Itoa(year))
+	return q
+}
+
+// FirstAirDate matches shows whose first episode aired between from and to.
+// A zero time leaves that bound open.
+func (q *DiscoverTVQuery) FirstAirDate(from, to time.Time) *DiscoverTVQuery {
+	q.dateRange("first_air_date", from, to)
+	return q
+}
+
+// AirDate matches shows with an episode airing between from and to. A zero
+// time leaves that bound open.
+func (q *DiscoverTVQuery) AirDate(from, to time.Time) *DiscoverTVQuery {
+	q.dateRange("air_date", from, to)
+	return q
+}
+
+// VoteAverage matches shows rated between min and max, from 0 to 10. Zero
+// leaves that bound open.
+func (q *DiscoverTVQuery) VoteAverage(min, max float64) *DiscoverTVQuery {
+	q.voteAverage(min, max)
+	return q
+}
+
+// MinVoteCount matches shows with at least min votes
+func (q *DiscoverTVQuery) MinVoteCount(min int) *DiscoverTVQuery {
+	q.intRange("vote_count", min, 0)
+	return q
+}
+
+// Runtime matches shows whose episodes last between min and max minutes.
+// Zero leaves that bound open.
+func (q *DiscoverTVQuery) Runtime(min, max int) *DiscoverTVQuery {
+	q.intRange("with_runtime", min, max)
+	return q
+}
+
+// WithGenres matches shows by genre id
+func (q *DiscoverTVQuery) WithGenres(filter IDFilter) *DiscoverTVQuery {
+	q.ids("with_genres", filter)
+	return q
+}
+
+// WithoutGenres excludes shows by genre id
+func (q *DiscoverTVQuery) WithoutGenres(ids ...int) *DiscoverTVQuery {
+	q.ids("without_genres", AllOf(ids...))
+	return q
+}
+
+// WithKeywords matches shows by keyword id
+func (q *DiscoverTVQuery) WithKeywords(filter IDFilter) *DiscoverTVQuery {
+	q.ids("with_keywords", filter)
+	return q
+}
+
+// WithoutKeywords excludes shows by keyword id
+func (q *DiscoverTVQuery) WithoutKeywords(ids ...int) *DiscoverTVQuery {
+	q.ids("without_keywords", AllOf(ids...))
+	return q
+}
+
+// WithCompanies matches shows by production company id
+func (q *DiscoverTVQuery) WithCompanies(filter IDFilter) *DiscoverTVQuery {
+	q.ids("with_companies", filter)
+	return q
+}
+
+// WithoutCompanies excludes shows by production company id
+func (q *DiscoverTVQuery) WithoutCompanies(ids ...int) *DiscoverTVQuery {
+	q.ids("without_companies", AllOf(ids...))
+	return q
+}
+
+// WithNetworks matches shows by network id
+func (q *DiscoverTVQuery) WithNetworks(filter IDFilter) *DiscoverTVQuery {
+	q.ids("with_networks", filter)
+	return q
+}
+
+// WithOriginalLanguage matches shows by ISO 639-1 original language
+func (q *DiscoverTVQuery) WithOriginalLanguage(language string) *DiscoverTVQuery {
+	q.set("with_original_language", language)
+	return q
+}
+
+// WithOriginCountry matches shows by ISO 3166-1 origin country
+func (q *DiscoverTVQuery) WithOriginCountry(country string) *DiscoverTVQuery {
+	q.set("with_origin_country", country)
+	return q
+}
+
+// WithWatchProviders matches shows offered in region by the given providers,
+// optionally only through the given monetization types
+func (q *DiscoverTVQuery) WithWatchProviders(region string, providers IDFilter, types ...MonetizationType) *DiscoverTVQuery {
+	q.watchProviders(region, providers, types)
+	return q
+}
@@ -1,6 +1,10 @@
 package tmdb
 
 import (
+	"errors"
+	"net/http"
+	"time"
+
 	. "gopkg.in/check.v1"
 )
 
@@ -121,3 +125,95 @@ func (s *TmdbSuite) TestDiscoverTV(c *C) {
 	c.Assert(rated2010Tv.Results, NotNil)
 	c.Assert(rated2010Tv.Results, Not(HasLen), 0)
 }
+
+func (s *LocalSuite) TestDiscoverMovieQuery(c *C) {
+	options, err := NewDiscoverMovieQuery().
+		WithGenres(AnyOf(28, 12)).
+		WithoutGenres(27, 53).
+		WithCast(AllOf(31, 12898)).
+		PrimaryReleaseDate(time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC), time.Time{}).
+		Runtime(90, 150).
+		VoteAverage(6.5, 0).
+		WithReleaseTypes(ReleaseTheatrical, ReleaseDigital).
+		WithWatchProviders("US", AnyOf(8, 337), MonetizationFlatrate, MonetizationFree).
+		WithOriginalLanguage("en").
+		SortBy(MovieSortPopularity, Descending).
+		Page(3).
+		Options()
+	c.Assert(err, IsNil)
+	c.Assert(options, DeepEquals, map[string]string{
+		"with_genres":                   "28|12",
+		"without_genres":                "27,53",
+		"with_cast":                     "31,12898",
+		"primary_release_date.gte":      "2020-01-01",
+		"with_runtime.gte":              "90",
+		"with_runtime.lte":              "150",
+		"vote_average.gte":              "6.5",
+		"with_release_type":             "3|4",
+		"watch_region":                  "US",
+		"with_watch_providers":          "8|337",
+		"with_watch_monetization_types": "flatrate|free",
+		"with_original_language":        "en",
+		"sort_by":                       "popularity.desc",
+		"page":                          "3",
+	})
+}
+
+func (s *LocalSuite) TestDiscoverQueryValidation(c *C) {
+	jan := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	dec := time.Date(2020, time.December, 1, 0, 0, 0, 0, time.UTC)
+	for _, q := range []interface {
+		Options() (map[string]string, error)
+	}{
+		NewDiscoverMovieQuery().Page(0),
+		NewDiscoverMovieQuery().Page(MaxPage + 1),
+		NewDiscoverMovieQuery().SortBy("budget", Ascending),
+		NewDiscoverMovieQuery().SortBy(MovieSortRevenue, "up"),
+		NewDiscoverMovieQuery().ReleaseDate(dec, jan),
+		NewDiscoverMovieQuery().Runtime(150, 90),
+		NewDiscoverMovieQuery().VoteAverage(0, 11),
+		NewDiscoverTVQuery().VoteAverage(11, 0),
+		NewDiscoverMovieQuery().WithGenres(AnyOf()),
+		NewDiscoverMovieQuery().WithReleaseTypes(7),
+		NewDiscoverMovieQuery().WithWatchProviders("", AnyOf(8)),
+		NewDiscoverTVQuery().WithWatchProviders("US", AnyOf(8), "stream"),
+		NewDiscoverTVQuery().FirstAirDate(dec, jan),
+		NewDiscoverTVQuery().SortBy("revenue", Descending),
+	} {
+		options, err := q.Options()
+		c.Check(options, IsNil)
+		c.Check(errors.Is(err, ErrInvalidDiscoverQuery), Equals, true, Commentf("%v", err))
+	}
+}
+
+func (s *LocalSuite) TestDiscoverTVWith(c *C) {
+	var got map[string]string
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/discover/tv")
+		got = map[string]string{}
+		for key := range r.URL.Query() {
+			got[key] = r.URL.Query().Get(key)
+		}
+		w.Write([]byte(`{"page":1,"results":[],"total_pages":1,"total_results":0}`))
+	})
+	defer server.Close()
+
+	_, err := tmdb.DiscoverTVWith(NewDiscoverTVQuery().
+		WithGenres(AllOf(16)).
+		WithKeywords(AnyOf(210024, 287501)).
+		WithOriginCountry("JP").
+		SortBy(TvSortFirstAirDate, Ascending))
+	c.Assert(err, IsNil)
+	c.Assert(got, DeepEquals, map[string]string{
+		"api_key":             "key",
+		"with_genres":         "16",
+		"with_keywords":       "210024|287501",
+		"with_origin_country": "JP",
+		"sort_by":             "first_air_date.asc",
+	})
+
+	got = nil
+	_, err = tmdb.DiscoverTVWith(NewDiscoverTVQuery().Page(0))
+	c.Assert(errors.Is(err, ErrInvalidDiscoverQuery), Equals, true)
+	c.Assert(got, IsNil)
+}
@@ -0,0 +1,62 @@
+package tmdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// TMDb status codes, as documented at
+// https://developers.themoviedb.org/3/getting-started/status-codes
+const (
+	StatusAuthenticationFailed = 3
+	StatusInvalidID            = 6
+	StatusInvalidAPIKey        = 7
+	StatusServiceOffline       = 9
+	StatusSuspendedAPIKey      = 10
+	StatusRequestCountOver     = 25
+	StatusResourceNotFound     = 34
+)
+
+// Sentinel errors matched by APIError through errors.Is
+var (
+	ErrNotFound           = errors.New("resource not found")
+	ErrInvalidAPIKey      = errors.New("invalid API key")
+	ErrUnauthorized       = errors.New("unauthorized")
+	ErrRateLimited        = errors.New("rate limit exceeded")
+	ErrServiceUnavailable = errors.New("service unavailable")
+)
+
+// ErrAccessTokenRequired is returned by v4 methods called without a token:
+// the v4 API does not accept the api_key, so it needs Config.ReadAccessToken
+// or a user access token
+var ErrAccessTokenRequired = errors.New("v4 API needs a read access token or a user access token")
+
+// APIError is returned when the API answers with a non-2xx status
+type APIError struct {
+	StatusCode int    // HTTP status code
+	Code       int    // TMDb status_code, 0 when the body carried none
+	Message    string // TMDb status_message, or the raw body when it was not JSON
+	Path       string // Request path, without the query string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s: status %d, code (%d): %s", e.Path, e.StatusCode, e.Code, e.Message)
+}
+
+// Is reports whether e matches one of the package sentinel errors
+func (e *APIError) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.Code == StatusResourceNotFound || e.Code == StatusInvalidID || e.StatusCode == http.StatusNotFound
+	case ErrInvalidAPIKey:
+		return e.Code == StatusInvalidAPIKey || e.Code == StatusSuspendedAPIKey
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized || e.Code == StatusAuthenticationFailed
+	case ErrRateLimited:
+		return e.Code == StatusRequestCountOver || e.StatusCode == http.StatusTooManyRequests
+	case ErrServiceUnavailable:
+		return e.Code == StatusServiceOffline || e.StatusCode == http.StatusServiceUnavailable
+	}
+	return false
+}
@@ -0,0 +1,66 @@
+package tmdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *LocalSuite) TestAPIErrorFromStatusPayload(c *C) {
+	api, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"status_code": 34, "status_message": "The resource you requested could not be found."}`)
+	})
+	defer server.Close()
+
+	_, err := api.GetMovieInfo(0, nil)
+	c.Assert(errors.Is(err, ErrNotFound), Equals, true)
+	c.Assert(errors.Is(err, ErrInvalidAPIKey), Equals, false)
+
+	var apiErr *APIError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, http.StatusNotFound)
+	c.Assert(apiErr.Code, Equals, StatusResourceNotFound)
+	c.Assert(apiErr.Message, Equals, "The resource you requested could not be found.")
+	c.Assert(apiErr.Path, Equals, "/movie/0")
+	c.Assert(err, ErrorMatches, `/movie/0: status 404, code \(34\): The resource .*`)
+}
+
+func (s *LocalSuite) TestAPIErrorFromRawBody(c *C) {
+	api, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "<html>Bad Gateway</html>\n")
+	})
+	defer server.Close()
+
+	_, err := api.GetMovieInfo(550, nil)
+	var apiErr *APIError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.StatusCode, Equals, http.StatusBadGateway)
+	c.Assert(apiErr.Code, Equals, 0)
+	c.Assert(apiErr.Message, Equals, "<html>Bad Gateway</html>")
+}
+
+func (s *LocalSuite) TestAPIErrorIs(c *C) {
+	tests := []struct {
+		err    *APIError
+		target error
+		want   bool
+	}{
+		{&APIError{StatusCode: 401, Code: StatusInvalidAPIKey}, ErrInvalidAPIKey, true},
+		{&APIError{StatusCode: 401, Code: StatusInvalidAPIKey}, ErrUnauthorized, true},
+		{&APIError{StatusCode: 401, Code: StatusSuspendedAPIKey}, ErrInvalidAPIKey, true},
+		{&APIError{StatusCode: 401, Code: StatusAuthenticationFailed}, ErrInvalidAPIKey, false},
+		{&APIError{StatusCode: 429, Code: StatusRequestCountOver}, ErrRateLimited, true},
+		{&APIError{StatusCode: 429}, ErrRateLimited, true},
+		{&APIError{StatusCode: 404, Code: StatusInvalidID}, ErrNotFound, true},
+		{&APIError{StatusCode: 503, Code: StatusServiceOffline}, ErrServiceUnavailable, true},
+		{&APIError{StatusCode: 500}, ErrServiceUnavailable, false},
+		{&APIError{StatusCode: 404}, ErrRateLimited, false},
+	}
+	for _, test := range tests {
+		c.Check(errors.Is(test.err, test.target), Equals, test.want, Commentf("%s is %v", test.err, test.target))
+	}
+}
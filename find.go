@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // FindResults struct
@@ -35,8 +36,12 @@ func (tmdb *TMDb) GetFind(id, source string, options map[string]string) (*FindRe
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var results FindResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/find/%s?api_key=%s&external_source=%s%s", baseURL, id, tmdb.apiKey, source, optionsString)
-	result, err := getTmdb(uri, &results)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("external_source", source)
+	uri := tmdb.buildURL(fmt.Sprintf("/find/%s", url.PathEscape(id)), query)
+	result, err := tmdb.getTmdb(uri, &results)
 	return result.(*FindResults), err
 }
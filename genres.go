@@ -1,9 +1,5 @@
 package tmdb
 
-import (
-	"fmt"
-)
-
 // Genre struct
 type Genre struct {
 	Genres []struct {
@@ -18,9 +14,12 @@ func (tmdb *TMDb) GetMovieGenres(options map[string]string) (*Genre, error) {
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var movieGenres Genre
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/genre/movie/list?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &movieGenres)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/genre/movie/list", query)
+	result, err := tmdb.getTmdb(uri, &movieGenres)
 	return result.(*Genre), err
 }
 
@@ -30,8 +29,11 @@ func (tmdb *TMDb) GetTvGenres(options map[string]string) (*Genre, error) {
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var tvGenres Genre
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/genre/tv/list?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &tvGenres)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/genre/tv/list", query)
+	result, err := tmdb.getTmdb(uri, &tvGenres)
 	return result.(*Genre), err
 }
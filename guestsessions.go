@@ -2,8 +2,47 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
 )
 
+// RatedTvResults struct
+type RatedTvResults struct {
+	Page         int
+	Results      []RatedTv
+	TotalPages   int `json:"total_pages"`
+	TotalResults int `json:"total_results"`
+}
+
+// RatedTv struct
+type RatedTv struct {
+	TvShort
+	Rating float32 `json:"rating"`
+}
+
+// RatedTvEpisodeResults struct
+type RatedTvEpisodeResults struct {
+	Page         int
+	Results      []RatedTvEpisode
+	TotalPages   int `json:"total_pages"`
+	TotalResults int `json:"total_results"`
+}
+
+// RatedTvEpisode struct
+type RatedTvEpisode struct {
+	AirDate        string `json:"air_date"`
+	EpisodeNumber  int    `json:"episode_number"`
+	ID             int
+	Name           string
+	Overview       string
+	ProductionCode string  `json:"production_code"`
+	SeasonNumber   int     `json:"season_number"`
+	ShowID         int     `json:"show_id"`
+	StillPath      string  `json:"still_path"`
+	VoteAverage    float32 `json:"vote_average"`
+	VoteCount      int     `json:"vote_count"`
+	Rating         float32 `json:"rating"`
+}
+
 // GetGuestSessionRatedMovies gets the list of rated movies for a specific guest session id
 // https://developers.themoviedb.org/3/guest-sessions/get-guest-session-rated-movies
 func (tmdb *TMDb) GetGuestSessionRatedMovies(sessionID string, options map[string]string) (*MoviePagedResults, error) {
@@ -13,8 +52,45 @@ func (tmdb *TMDb) GetGuestSessionRatedMovies(sessionID string, options map[strin
 		"sort_order": {},
 		"language":   {}}
 	var favorites MoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/guest_session/%v/rated_movies?api_key=%s%s", baseURL, sessionID, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &favorites)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/guest_session/%v/rated_movies", url.PathEscape(sessionID)), query)
+	result, err := tmdb.getTmdb(uri, &favorites)
 	return result.(*MoviePagedResults), err
 }
+
+// GetGuestSessionRatedTv gets the list of rated TV shows for a specific guest session id
+// https://developers.themoviedb.org/3/guest-sessions/get-gest-session-rated-tv-shows
+func (tmdb *TMDb) GetGuestSessionRatedTv(sessionID string, options map[string]string) (*RatedTvResults, error) {
+	var availableOptions = map[string]struct{}{
+		"page":     {},
+		"sort_by":  {},
+		"language": {}}
+	var rated RatedTvResults
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/guest_session/%v/rated/tv", url.PathEscape(sessionID)), query)
+	result, err := tmdb.getTmdb(uri, &rated)
+	return result.(*RatedTvResults), err
+}
+
+// GetGuestSessionRatedTvEpisodes gets the list of rated TV episodes for a specific guest session id
+// https://developers.themoviedb.org/3/guest-sessions/get-guest-session-rated-tv-episodes
+func (tmdb *TMDb) GetGuestSessionRatedTvEpisodes(sessionID string, options map[string]string) (*RatedTvEpisodeResults, error) {
+	var availableOptions = map[string]struct{}{
+		"page":     {},
+		"sort_by":  {},
+		"language": {}}
+	var rated RatedTvEpisodeResults
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/guest_session/%v/rated/tv/episodes", url.PathEscape(sessionID)), query)
+	result, err := tmdb.getTmdb(uri, &rated)
+	return result.(*RatedTvEpisodeResults), err
+}
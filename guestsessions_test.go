@@ -1,6 +1,9 @@
 package tmdb
 
 import (
+	"errors"
+	"net/http"
+
 	. "gopkg.in/check.v1"
 )
 
@@ -8,3 +11,75 @@ func (s *TmdbSuite) TestGetGuestSessionRatedMovies(c *C) {
 	result, err := s.tmdb.GetGuestSessionRatedMovies(s.guestSession, nil)
 	s.baseTest(&result, err, c)
 }
+
+func (s *TmdbSuite) TestGetGuestSessionRatedTv(c *C) {
+	result, err := s.tmdb.GetGuestSessionRatedTv(s.guestSession, nil)
+	s.baseTest(&result, err, c)
+}
+
+func (s *TmdbSuite) TestGetGuestSessionRatedTvEpisodes(c *C) {
+	result, err := s.tmdb.GetGuestSessionRatedTvEpisodes(s.guestSession, nil)
+	s.baseTest(&result, err, c)
+}
+
+func (s *LocalSuite) TestGuestSessionRatedTv(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/guest_session/guest/rated/tv":
+			w.Write([]byte(`{"page":1,"results":[{"id":1399,"name":"Game of Thrones","rating":9.5}],"total_pages":1,"total_results":1}`))
+		case "/guest_session/guest/rated/tv/episodes":
+			w.Write([]byte(`{"page":1,"results":[{"id":63056,"show_id":1399,"season_number":1,"episode_number":1,"name":"Winter Is Coming","rating":8}],"total_pages":1,"total_results":1}`))
+		default:
+			c.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	shows, err := tmdb.GetGuestSessionRatedTv("guest", nil)
+	c.Assert(err, IsNil)
+	c.Assert(shows.Results, HasLen, 1)
+	c.Assert(shows.Results[0].Name, Equals, "Game of Thrones")
+	c.Assert(shows.Results[0].Rating, Equals, float32(9.5))
+
+	episodes, err := tmdb.GetGuestSessionRatedTvEpisodes("guest", nil)
+	c.Assert(err, IsNil)
+	c.Assert(episodes.Results, DeepEquals, []RatedTvEpisode{{
+		ID: 63056, ShowID: 1399, SeasonNumber: 1, EpisodeNumber: 1, Name: "Winter Is Coming", Rating: 8,
+	}})
+}
+
+func (s *LocalSuite) TestGuestRatings(c *C) {
+	tmdb, server, requests := s.newWriteServer(c)
+	defer server.Close()
+
+	_, err := tmdb.RateMovieAsGuest(fightClubID, "guest", 7)
+	c.Assert(err, IsNil)
+	_, err = tmdb.DeleteMovieRatingAsGuest(fightClubID, "guest")
+	c.Assert(err, IsNil)
+	_, err = tmdb.RateTvAsGuest(gameOfThronesID, "guest", 9)
+	c.Assert(err, IsNil)
+	_, err = tmdb.DeleteTvRatingAsGuest(gameOfThronesID, "guest")
+	c.Assert(err, IsNil)
+	_, err = tmdb.RateTvEpisodeAsGuest(gameOfThronesID, 1, 1, "guest", 8)
+	c.Assert(err, IsNil)
+	_, err = tmdb.DeleteTvEpisodeRatingAsGuest(gameOfThronesID, 1, 1, "guest")
+	c.Assert(err, IsNil)
+	_, err = tmdb.RateTvAsGuest(gameOfThronesID, "guest", 11)
+	c.Assert(errors.Is(err, ErrInvalidRating), Equals, true)
+
+	var seen []string
+	for _, req := range *requests {
+		c.Check(req.query.Get("guest_session_id"), Equals, "guest")
+		c.Check(req.query.Has("session_id"), Equals, false)
+		seen = append(seen, req.method+" "+req.path)
+	}
+	c.Assert(seen, DeepEquals, []string{
+		"POST /movie/550/rating",
+		"DELETE /movie/550/rating",
+		"POST /tv/1399/rating",
+		"DELETE /tv/1399/rating",
+		"POST /tv/1399/season/1/episode/1/rating",
+		"DELETE /tv/1399/season/1/episode/1/rating",
+	})
+	c.Assert((*requests)[4].body, DeepEquals, map[string]interface{}{"value": 8.0})
+}
@@ -0,0 +1,217 @@
+package tmdb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ImageKind type
+type ImageKind string
+
+// Kinds of images, each with its own set of sizes in the configuration
+const (
+	ImageBackdrop ImageKind = "backdrop"
+	ImageLogo     ImageKind = "logo"
+	ImagePoster   ImageKind = "poster"
+	ImageProfile  ImageKind = "profile"
+	ImageStill    ImageKind = "still"
+)
+
+// ImageSizeOriginal is the size name of the image as it was uploaded
+const ImageSizeOriginal = "original"
+
+// ErrInvalidImageSize is returned when a size is not listed in the
+// configuration for that kind of image
+var ErrInvalidImageSize = errors.New("invalid image size")
+
+// imageConfig caches the configuration used to build image URLs. It is shared
+// by the copies returned by WithContext.
+type imageConfig struct {
+	mu     sync.Mutex
+	config *Configuration
+}
+
+// ImageSizes returns the size names available for kind, e.g. "w92" or
+// "original"
+func (config *Configuration) ImageSizes(kind ImageKind) []string {
+	switch kind {
+	case ImageBackdrop:
+		return config.Images.BackdropSizes
+	case ImageLogo:
+		return config.Images.LogoSizes
+	case ImagePoster:
+		return config.Images.PosterSizes
+	case ImageProfile:
+		return config.Images.ProfileSizes
+	case ImageStill:
+		return config.Images.StillSizes
+	}
+	return nil
+}
+
+// ImageURL builds the URL of an image from the path returned by the API, e.g.
+// a PosterPath. size must be one of ImageSizes(kind).
+func (config *Configuration) ImageURL(kind ImageKind, size, path string) (string, error) {
+	for _, available := range config.ImageSizes(kind) {
+		if available == size {
+			return config.imageURL(size, path), nil
+		}
+	}
+	return "", fmt.Errorf("%w: %q for %s images", ErrInvalidImageSize, size, kind)
+}
+
+// ClosestImageSize returns the smallest size of kind that is at least width
+// pixels wide, or "original" when none is
+func (config *Configuration) ClosestImageSize(kind ImageKind, width int) string {
+	closest, closestWidth := ImageSizeOriginal, 0
+	for _, size := range config.ImageSizes(kind) {
+		if !strings.HasPrefix(size, "w") {
+			continue
+		}
+		sizeWidth, err := strconv.Atoi(size[1:])
+		if err != nil || sizeWidth < width {
+			continue
+		}
+		if closestWidth == 0 || sizeWidth < closestWidth {
+			closest, closestWidth = size, sizeWidth
+		}
+	}
+	return closest
+}
+
+// ImageURLForWidth builds the URL of an image in the closest size to width
+// pixels. It returns an empty string when path is empty.
+func (config *Configuration) ImageURLForWidth(kind ImageKind, path string, width int) string {
+	if path == "" {
+		return ""
+	}
+	return config.imageURL(config.ClosestImageSize(kind, width), path)
+}
+
+func (config *Configuration) imageURL(size, path string) string {
+	baseURL := config.Images.SecureBaseURL
+	if baseURL == "" {
+		baseURL = config.Images.BaseURL
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/" + size + "/" + strings.TrimPrefix(path, "/")
+}
+
+// ImageConfiguration returns the configuration used to build image URLs. It
+// is fetched once with GetConfiguration and then kept for the lifetime of
+// tmdb; failed fetches are retried on the next call.
+func (tmdb *TMDb) ImageConfiguration() (*Configuration, error) {
+	if tmdb.images == nil {
+		return tmdb.GetConfiguration()
+	}
+	tmdb.images.mu.Lock()
+	cached := tmdb.images.config
+	tmdb.images.mu.Unlock()
+	if cached != nil {
+		return cached, nil
+	}
+
+	// Fetch without holding the lock, so a slow fetch does not block callers
+	// once another one has stored the configuration
+	config, err := tmdb.GetConfiguration()
+	if err != nil {
+		return nil, err
+	}
+	tmdb.images.mu.Lock()
+	defer tmdb.images.mu.Unlock()
+	if tmdb.images.config == nil {
+		tmdb.images.config = config
+	}
+	return tmdb.images.config, nil
+}
+
+// ImageURL builds the URL of an image in the closest size to width pixels,
+// using the cached configuration. It returns an empty string when path is
+// empty.
+func (tmdb *TMDb) ImageURL(kind ImageKind, path string, width int) (string, error) {
+	if path == "" {
+		return "", nil
+	}
+	config, err := tmdb.ImageConfiguration()
+	if err != nil {
+		return "", err
+	}
+	return config.ImageURLForWidth(kind, path, width), nil
+}
+
+// PosterURL returns the URL of the poster in the closest size to width pixels
+func (movie *Movie) PosterURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImagePoster, movie.PosterPath, width)
+}
+
+// BackdropURL returns the URL of the backdrop in the closest size to width pixels
+func (movie *Movie) BackdropURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageBackdrop, movie.BackdropPath, width)
+}
+
+// PosterURL returns the URL of the poster in the closest size to width pixels
+func (movie *MovieShort) PosterURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImagePoster, movie.PosterPath, width)
+}
+
+// BackdropURL returns the URL of the backdrop in the closest size to width pixels
+func (movie *MovieShort) BackdropURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageBackdrop, movie.BackdropPath, width)
+}
+
+// PosterURL returns the URL of the poster in the closest size to width pixels
+func (tv *TV) PosterURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImagePoster, tv.PosterPath, width)
+}
+
+// BackdropURL returns the URL of the backdrop in the closest size to width pixels
+func (tv *TV) BackdropURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageBackdrop, tv.BackdropPath, width)
+}
+
+// PosterURL returns the URL of the poster in the closest size to width pixels
+func (tv *TvShort) PosterURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImagePoster, tv.PosterPath, width)
+}
+
+// BackdropURL returns the URL of the backdrop in the closest size to width pixels
+func (tv *TvShort) BackdropURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageBackdrop, tv.BackdropPath, width)
+}
+
+// PosterURL returns the URL of the poster in the closest size to width pixels
+func (season *TvSeason) PosterURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImagePoster, season.PosterPath, width)
+}
+
+// StillURL returns the URL of the still in the closest size to width pixels
+func (episode *TvEpisode) StillURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageStill, episode.StillPath, width)
+}
+
+// ProfileURL returns the URL of the profile picture in the closest size to width pixels
+func (person *Person) ProfileURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageProfile, person.ProfilePath, width)
+}
+
+// PosterURL returns the URL of the poster in the closest size to width pixels
+func (collection *Collection) PosterURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImagePoster, collection.PosterPath, width)
+}
+
+// BackdropURL returns the URL of the backdrop in the closest size to width pixels
+func (collection *Collection) BackdropURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageBackdrop, collection.BackdropPath, width)
+}
+
+// LogoURL returns the URL of the logo in the closest size to width pixels
+func (company *Company) LogoURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageLogo, company.LogoPath, width)
+}
+
+// LogoURL returns the URL of the logo in the closest size to width pixels
+func (network *Network) LogoURL(config *Configuration, width int) string {
+	return config.ImageURLForWidth(ImageLogo, network.LogoPath, width)
+}
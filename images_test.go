@@ -0,0 +1,75 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *LocalSuite) TestImageURL(c *C) {
+	server := newConfigurationServer()
+	defer server.Close()
+	config, err := Init(Config{APIKey: "key", BaseURL: server.URL}).GetConfiguration()
+	c.Assert(err, IsNil)
+
+	uri, err := config.ImageURL(ImagePoster, "w342", "/poster.jpg")
+	c.Assert(err, IsNil)
+	c.Assert(uri, Equals, "https://image.tmdb.org/t/p/w342/poster.jpg")
+	uri, err = config.ImageURL(ImageProfile, "h632", "/profile.jpg")
+	c.Assert(err, IsNil)
+	c.Assert(uri, Equals, "https://image.tmdb.org/t/p/h632/profile.jpg")
+
+	_, err = config.ImageURL(ImagePoster, "w45", "/poster.jpg")
+	c.Assert(errors.Is(err, ErrInvalidImageSize), Equals, true)
+	_, err = config.ImageURL(ImageKind("banner"), "original", "/banner.jpg")
+	c.Assert(errors.Is(err, ErrInvalidImageSize), Equals, true)
+
+	c.Assert(config.ClosestImageSize(ImagePoster, 0), Equals, "w92")
+	c.Assert(config.ClosestImageSize(ImagePoster, 200), Equals, "w342")
+	c.Assert(config.ClosestImageSize(ImagePoster, 342), Equals, "w342")
+	c.Assert(config.ClosestImageSize(ImagePoster, 1000), Equals, "original")
+	c.Assert(config.ClosestImageSize(ImageProfile, 300), Equals, "original")
+	c.Assert(config.ClosestImageSize(ImageBackdrop, 800), Equals, "w1280")
+
+	movie := Movie{PosterPath: "/poster.jpg"}
+	c.Assert(movie.PosterURL(config, 150), Equals, "https://image.tmdb.org/t/p/w154/poster.jpg")
+	c.Assert(movie.BackdropURL(config, 300), Equals, "")
+	episode := TvEpisode{StillPath: "/still.jpg"}
+	c.Assert(episode.StillURL(config, 2000), Equals, "https://image.tmdb.org/t/p/original/still.jpg")
+	network := Network{LogoPath: "/logo.png"}
+	c.Assert(network.LogoURL(config, 45), Equals, "https://image.tmdb.org/t/p/w45/logo.png")
+}
+
+func (s *LocalSuite) TestImageConfigurationIsCached(c *C) {
+	hits, fail := 0, true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		if fail {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"status_code":7,"status_message":"Invalid API key"}`)
+			return
+		}
+		fmt.Fprint(w, configurationFixture)
+	}))
+	defer server.Close()
+	tmdb := Init(Config{APIKey: "key", BaseURL: server.URL, ImageBaseURL: "https://images.mirror.local/t/p"})
+
+	_, err := tmdb.ImageURL(ImagePoster, "/poster.jpg", 500)
+	c.Assert(err, NotNil)
+
+	fail = false
+	uri, err := tmdb.ImageURL(ImagePoster, "/poster.jpg", 500)
+	c.Assert(err, IsNil)
+	c.Assert(uri, Equals, "https://images.mirror.local/t/p/w500/poster.jpg")
+	uri, err = tmdb.WithContext(context.Background()).ImageURL(ImageBackdrop, "/backdrop.jpg", 1)
+	c.Assert(err, IsNil)
+	c.Assert(uri, Equals, "https://images.mirror.local/t/p/w300/backdrop.jpg")
+	uri, err = tmdb.ImageURL(ImageStill, "", 500)
+	c.Assert(err, IsNil)
+	c.Assert(uri, Equals, "")
+	c.Assert(hits, Equals, 2)
+}
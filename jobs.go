@@ -1,9 +1,5 @@
 package tmdb
 
-import (
-	"fmt"
-)
-
 // Job struct
 type Job struct {
 	Jobs []struct {
@@ -16,7 +12,7 @@ type Job struct {
 // https://developers.themoviedb.org/3/configuration/get-jobs
 func (tmdb *TMDb) GetJobList() (*Job, error) {
 	var jobList Job
-	uri := fmt.Sprintf("%s/job/list?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &jobList)
+	uri := tmdb.buildURL("/job/list", nil)
+	result, err := tmdb.getTmdb(uri, &jobList)
 	return result.(*Job), err
 }
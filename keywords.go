@@ -14,8 +14,8 @@ type Keyword struct {
 // https://developers.themoviedb.org/3/keywords/get-keyword-details
 func (tmdb *TMDb) GetKeywordInfo(id int) (*Keyword, error) {
 	var keywordInfo Keyword
-	uri := fmt.Sprintf("%s/keyword/%v?api_key=%s", baseURL, id, tmdb.apiKey)
-	result, err := getTmdb(uri, &keywordInfo)
+	uri := tmdb.buildURL(fmt.Sprintf("/keyword/%v", id), nil)
+	result, err := tmdb.getTmdb(uri, &keywordInfo)
 	return result.(*Keyword), err
 }
 
@@ -26,8 +26,11 @@ func (tmdb *TMDb) GetKeywordMovies(id int, options map[string]string) (*MoviePag
 		"language": {},
 		"page":     {}}
 	var movies MoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/keyword/%v/movies?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &movies)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/keyword/%v/movies", id), query)
+	result, err := tmdb.getTmdb(uri, &movies)
 	return result.(*MoviePagedResults), err
 }
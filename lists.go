@@ -2,6 +2,8 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
+	"strconv"
 )
 
 // ListInfo struct
@@ -17,6 +19,12 @@ type ListInfo struct {
 	PosterPath    string `json:"poster_path"`
 }
 
+// ListCreateStatus struct
+type ListCreateStatus struct {
+	Status
+	ListID int `json:"list_id"`
+}
+
 // ListItemStatus struct
 type ListItemStatus struct {
 	ID          string
@@ -27,40 +35,70 @@ type ListItemStatus struct {
 // https://developers.themoviedb.org/3/lists/get-list-details
 func (tmdb *TMDb) GetListInfo(id string) (*ListInfo, error) {
 	var listInfo ListInfo
-	uri := fmt.Sprintf("%s/list/%v?api_key=%s", baseURL, id, tmdb.apiKey)
-	result, err := getTmdb(uri, &listInfo)
+	uri := tmdb.buildURL(fmt.Sprintf("/list/%v", url.PathEscape(id)), nil)
+	result, err := tmdb.getTmdb(uri, &listInfo)
 	return result.(*ListInfo), err
 }
 
+// CreateList creates a list owned by the account of the session
+// https://developers.themoviedb.org/3/lists/create-list
+func (tmdb *TMDb) CreateList(sessionID, name, description, language string) (*ListCreateStatus, error) {
+	body := struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+		Language    string `json:"language,omitempty"`
+	}{name, description, language}
+	var created ListCreateStatus
+	uri := tmdb.buildURL("/list", url.Values{"session_id": {sessionID}})
+	result, err := tmdb.postTmdb(uri, body, &created)
+	return result.(*ListCreateStatus), err
+}
+
 // DeleteList deletes a list by id
 // https://developers.themoviedb.org/3/lists/delete-list
-// func (tmdb *TMDb) DeleteList(id string) {
-// TODO
-// }
+func (tmdb *TMDb) DeleteList(id, sessionID string) (*Status, error) {
+	var status Status
+	uri := tmdb.buildURL(fmt.Sprintf("/list/%v", url.PathEscape(id)), url.Values{"session_id": {sessionID}})
+	result, err := tmdb.deleteTmdb(uri, nil, &status)
+	return result.(*Status), err
+}
 
 // GetListItemStatus checks to see if a movie ID is already added to a list
 // hhttps://developers.themoviedb.org/3/lists/check-item-status
 func (tmdb *TMDb) GetListItemStatus(id string, movieID int) (*ListItemStatus, error) {
 	var itemStatus ListItemStatus
-	uri := fmt.Sprintf("%s/list/%v/item_status?api_key=%s&movie_id=%v", baseURL, id, tmdb.apiKey, movieID)
-	result, err := getTmdb(uri, &itemStatus)
+	uri := tmdb.buildURL(fmt.Sprintf("/list/%v/item_status", url.PathEscape(id)), url.Values{"movie_id": {strconv.Itoa(movieID)}})
+	result, err := tmdb.getTmdb(uri, &itemStatus)
 	return result.(*ListItemStatus), err
 }
 
 // PostListAddItem lets users add new movies to a list that they created
 // https://developers.themoviedb.org/3/lists/add-movie
-// func (tmdb *TMDb) PostListAddItem(id string) {
-// TODO
-// }
+func (tmdb *TMDb) PostListAddItem(id, sessionID string, movieID int) (*Status, error) {
+	return tmdb.postListItem(id, "add_item", sessionID, movieID)
+}
 
 // PostListRemoveItem lets users remove movies from a list that they created
 // https://developers.themoviedb.org/3/lists/remove-movie
-// func (tmdb *TMDb) PostListRemoveItem(id string) {
-// TODO
-// }
+func (tmdb *TMDb) PostListRemoveItem(id, sessionID string, movieID int) (*Status, error) {
+	return tmdb.postListItem(id, "remove_item", sessionID, movieID)
+}
+
+func (tmdb *TMDb) postListItem(id, action, sessionID string, movieID int) (*Status, error) {
+	body := struct {
+		MediaID int `json:"media_id"`
+	}{movieID}
+	var status Status
+	uri := tmdb.buildURL(fmt.Sprintf("/list/%v/%v", url.PathEscape(id), action), url.Values{"session_id": {sessionID}})
+	result, err := tmdb.postTmdb(uri, body, &status)
+	return result.(*Status), err
+}
 
 // PostListClear clears all of the items in a list
 // https://developers.themoviedb.org/3/lists/clear-list
-// func (tmdb *TMDb) PostListRemoveItem(id string) {
-// TODO
-// }
+func (tmdb *TMDb) PostListClear(id, sessionID string) (*Status, error) {
+	var status Status
+	uri := tmdb.buildURL(fmt.Sprintf("/list/%v/clear", url.PathEscape(id)), url.Values{"session_id": {sessionID}, "confirm": {"true"}})
+	result, err := tmdb.postTmdb(uri, nil, &status)
+	return result.(*Status), err
+}
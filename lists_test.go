@@ -1,6 +1,16 @@
 package tmdb
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
 	. "gopkg.in/check.v1"
 )
 
@@ -27,3 +37,174 @@ func (s *TmdbSuite) TestGetListItemStatus(c *C) {
 	c.Assert(argoResult.ID, Equals, oscarWinnerListID)
 	c.Assert(argoResult.ItemPresent, Equals, true)
 }
+
+// fakeLists is a stand-in for the list endpoints that keeps the lists it is
+// given in memory. Only requests made with its session may change them.
+type fakeLists struct {
+	mu      sync.Mutex
+	session string
+	nextID  int
+	lists   map[string]map[int]bool
+}
+
+func newFakeLists(session string) *fakeLists {
+	return &fakeLists{session: session, nextID: 1, lists: make(map[string]map[int]bool)}
+}
+
+func (f *fakeLists) reply(w http.ResponseWriter, status, code int, message, extra string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, `{"success":%t,"status_code":%d,"status_message":%q%s}`, status < 300, code, message, extra)
+}
+
+func (f *fakeLists) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/list"), "/")
+	if r.Method != http.MethodGet && r.URL.Query().Get("session_id") != f.session {
+		f.reply(w, http.StatusUnauthorized, StatusAuthenticationFailed, "Authentication failed: You do not have permissions to access the service.", "")
+		return
+	}
+
+	if r.Method == http.MethodPost && len(parts) == 1 {
+		var body struct{ Name string }
+		if json.NewDecoder(r.Body).Decode(&body) != nil || body.Name == "" {
+			f.reply(w, http.StatusUnprocessableEntity, 5, "Invalid parameters", "")
+			return
+		}
+		id := strconv.Itoa(f.nextID)
+		f.nextID++
+		f.lists[id] = make(map[int]bool)
+		f.reply(w, http.StatusCreated, 1, "The item/record was created successfully.", `,"list_id":`+id)
+		return
+	}
+
+	items, ok := f.lists[parts[1]]
+	if !ok {
+		f.reply(w, http.StatusNotFound, StatusResourceNotFound, "The resource you requested could not be found.", "")
+		return
+	}
+	action := ""
+	if len(parts) > 2 {
+		action = parts[2]
+	}
+
+	switch {
+	case r.Method == http.MethodGet && action == "":
+		var ids []int
+		for id := range items {
+			ids = append(ids, id)
+		}
+		sort.Ints(ids)
+		movies := make([]MovieShort, len(ids))
+		for i, id := range ids {
+			movies[i].ID = id
+		}
+		data, _ := json.Marshal(movies)
+		fmt.Fprintf(w, `{"id":%s,"item_count":%d,"items":%s}`, parts[1], len(ids), data)
+	case r.Method == http.MethodGet && action == "item_status":
+		id, _ := strconv.Atoi(r.URL.Query().Get("movie_id"))
+		fmt.Fprintf(w, `{"id":%q,"item_present":%t}`, parts[1], items[id])
+	case r.Method == http.MethodDelete && action == "":
+		delete(f.lists, parts[1])
+		f.reply(w, http.StatusOK, 12, "The item/record was updated successfully.", "")
+	case r.Method == http.MethodPost && (action == "add_item" || action == "remove_item"):
+		var body struct {
+			MediaID int `json:"media_id"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		if action == "add_item" && items[body.MediaID] {
+			f.reply(w, http.StatusForbidden, 8, "Duplicate entry: The data you tried to submit already exists.", "")
+			return
+		}
+		if action == "add_item" {
+			items[body.MediaID] = true
+			f.reply(w, http.StatusCreated, 12, "The item/record was updated successfully.", "")
+		} else {
+			delete(items, body.MediaID)
+			f.reply(w, http.StatusOK, 13, "The item/record was deleted successfully.", "")
+		}
+	case r.Method == http.MethodPost && action == "clear" && r.URL.Query().Get("confirm") == "true":
+		f.lists[parts[1]] = make(map[int]bool)
+		f.reply(w, http.StatusCreated, 12, "The item/record was updated successfully.", "")
+	default:
+		f.reply(w, http.StatusMethodNotAllowed, 0, "Unexpected request "+r.Method+" "+r.URL.Path, "")
+	}
+}
+
+func (s *LocalSuite) TestListWrites(c *C) {
+	tmdb, server := s.newServer(c, newFakeLists("session").ServeHTTP)
+	defer server.Close()
+
+	created, err := tmdb.CreateList("session", "Best of Fincher", "", "en")
+	c.Assert(err, IsNil)
+	c.Assert(created.Success, Equals, true)
+	c.Assert(created.ListID, Equals, 1)
+	id := strconv.Itoa(created.ListID)
+
+	for _, movieID := range []int{fightClubID, 807, 1422} {
+		status, err := tmdb.PostListAddItem(id, "session", movieID)
+		c.Assert(err, IsNil)
+		c.Assert(status.StatusCode, Equals, 12)
+	}
+	_, err = tmdb.PostListAddItem(id, "session", fightClubID)
+	var apiErr *APIError
+	c.Assert(errors.As(err, &apiErr), Equals, true)
+	c.Assert(apiErr.Code, Equals, 8)
+
+	_, err = tmdb.PostListRemoveItem(id, "session", 807)
+	c.Assert(err, IsNil)
+	info, err := tmdb.GetListInfo(id)
+	c.Assert(err, IsNil)
+	c.Assert(info.ItemCount, Equals, 2)
+	c.Assert(movieIDs(info.Items), DeepEquals, []int{fightClubID, 1422})
+	present, err := tmdb.GetListItemStatus(id, 807)
+	c.Assert(err, IsNil)
+	c.Assert(present.ItemPresent, Equals, false)
+
+	_, err = tmdb.PostListClear(id, "other")
+	c.Assert(errors.Is(err, ErrUnauthorized), Equals, true)
+	_, err = tmdb.PostListClear(id, "session")
+	c.Assert(err, IsNil)
+	info, err = tmdb.GetListInfo(id)
+	c.Assert(err, IsNil)
+	c.Assert(info.Items, HasLen, 0)
+
+	_, err = tmdb.DeleteList(id, "session")
+	c.Assert(err, IsNil)
+	_, err = tmdb.PostListAddItem(id, "session", fightClubID)
+	c.Assert(errors.Is(err, ErrNotFound), Equals, true)
+	_, err = tmdb.GetListInfo(id)
+	c.Assert(errors.Is(err, ErrNotFound), Equals, true)
+}
+
+func (s *LocalSuite) TestListWritesWithCache(c *C) {
+	server := httptest.NewServer(newFakeLists("session"))
+	defer server.Close()
+	tmdb := Init(Config{APIKey: "key", BaseURL: server.URL, Cache: NewMemoryCache(10)})
+
+	created, err := tmdb.CreateList("session", "Best of Fincher", "", "en")
+	c.Assert(err, IsNil)
+	id := strconv.Itoa(created.ListID)
+	info, err := tmdb.GetListInfo(id)
+	c.Assert(err, IsNil)
+	c.Assert(info.Items, HasLen, 0)
+	present, err := tmdb.GetListItemStatus(id, fightClubID)
+	c.Assert(err, IsNil)
+	c.Assert(present.ItemPresent, Equals, false)
+
+	_, err = tmdb.PostListAddItem(id, "session", fightClubID)
+	c.Assert(err, IsNil)
+	info, err = tmdb.GetListInfo(id)
+	c.Assert(err, IsNil)
+	c.Assert(movieIDs(info.Items), DeepEquals, []int{fightClubID})
+	present, err = tmdb.GetListItemStatus(id, fightClubID)
+	c.Assert(err, IsNil)
+	c.Assert(present.ItemPresent, Equals, true)
+
+	_, err = tmdb.PostListClear(id, "session")
+	c.Assert(err, IsNil)
+	info, err = tmdb.GetListInfo(id)
+	c.Assert(err, IsNil)
+	c.Assert(info.Items, HasLen, 0)
+}
@@ -1,20 +1,70 @@
 package tmdb
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
+	"strings"
+	"time"
 )
 
-const baseURL string = "https://api.themoviedb.org/3"
+const defaultBaseURL string = "https://api.themoviedb.org/3"
 
 // Config struct
 type Config struct {
 	APIKey   string
 	UseProxy bool
 	Proxies  []Proxy
+
+	// ReadAccessToken is the v4 "API Read Access Token". When set, it is sent
+	// as an "Authorization: Bearer" header instead of the api_key query
+	// parameter, which keeps the key out of URLs, logs and proxies.
+	ReadAccessToken string
+
+	// HTTPClient, when set, is used for every request. It takes precedence
+	// over Transport and the proxy settings.
+	HTTPClient *http.Client
+	// Transport, when set, is wrapped in an http.Client that is used for
+	// every request. It takes precedence over the proxy settings.
+	Transport http.RoundTripper
+
+	// BaseURL overrides the API root, e.g. to target a mirror, a caching
+	// proxy or an httptest.Server. Defaults to https://api.themoviedb.org/3.
+	BaseURL string
+	// V4BaseURL overrides the root of the v4 API. When empty, it is derived
+	// from BaseURL by replacing a trailing "/3" with "/4", or by appending
+	// "/4" when there is none.
+	V4BaseURL string
+	// ImageBaseURL overrides the image root reported by GetConfiguration.
+	// When empty, the one returned by the API is kept.
+	ImageBaseURL string
+
+	// Retry controls how failed GET requests are retried. The zero value
+	// makes a single attempt.
+	Retry RetryPolicy
+
+	// RateLimit caps the number of requests per second made through the
+	// returned TMDb value, across all goroutines. Zero disables the limit.
+	RateLimit float64
+	// RateBurst is the number of requests allowed to go out at once before
+	// RateLimit kicks in. Defaults to 1.
+	RateBurst int
+
+	// Cache, when set, stores successful GET responses for the TTL of their
+	// endpoint family. Responses tied to a session are never cached.
+	Cache Cache
+	// CacheTTLs overrides entries of DefaultCacheTTLs. A TTL of zero
+	// disables caching for that family.
+	CacheTTLs map[string]time.Duration
+
+	// DisableCoalescing makes concurrent identical GET requests go out
+	// separately instead of sharing one upstream response.
+	DisableCoalescing bool
 }
 
 // Proxy struct
@@ -28,32 +78,116 @@ type Proxy struct {
 
 // TMDb container struct for global properties
 type TMDb struct {
-	apiKey string
+	apiKey       string
+	accessToken  string
+	baseURL      string
+	v4BaseURL    string
+	imageBaseURL string
+	client       *http.Client
+	useProxy     bool
+	proxies      []Proxy
+	proxyClients []*http.Client
+	roundRobin   *RoundRobin
+	retry        RetryPolicy
+	limiter      *rateLimiter
+	cache        *responseCache
+	inflight     *inflightGroup
+	images       *imageConfig
+	ctx          context.Context
 }
 
-var internalConfig tmdbConfig
-
-type tmdbConfig struct {
-	useProxy   bool
-	proxies    []Proxy
-	roundRobin RoundRobin
-}
+// defaultHTTPClient is shared by every TMDb value that does not bring its own
+// client, so they all draw from the same connection pool.
+var defaultHTTPClient = &http.Client{Transport: newTransport()}
 
 type apiStatus struct {
 	Code    int    `json:"status_code"`
 	Message string `json:"status_message"`
 }
 
-// Init setup the apiKey
+// Status struct is returned by the POST and DELETE methods
+type Status struct {
+	Success       bool
+	StatusCode    int    `json:"status_code"`
+	StatusMessage string `json:"status_message"`
+}
+
+// Init setup the apiKey. Every TMDb value keeps its own configuration, so
+// differently configured values can be used side by side.
 func Init(config Config) *TMDb {
-	internalConfig := new(tmdbConfig)
-	if config.UseProxy && len(config.Proxies) > 1 {
-		internalConfig.useProxy = config.UseProxy
-		internalConfig.proxies = prepareProxies(config.Proxies)
-		internalConfig.roundRobin = InitRoundRobin(len(internalConfig.proxies))
+	tmdb := &TMDb{
+		apiKey:      config.APIKey,
+		accessToken: config.ReadAccessToken,
+		retry:       config.Retry,
+		images:      &imageConfig{},
+	}
+
+	tmdb.client = config.HTTPClient
+	if tmdb.client == nil && config.Transport != nil {
+		tmdb.client = &http.Client{Transport: config.Transport}
+	}
+
+	if tmdb.client == nil && config.UseProxy && len(config.Proxies) > 0 {
+		tmdb.useProxy = true
+		tmdb.proxies = prepareProxies(config.Proxies)
+		tmdb.proxyClients = prepareProxyClients(tmdb.proxies)
+		roundRobin := InitRoundRobin(len(tmdb.proxies))
+		tmdb.roundRobin = &roundRobin
+	}
+
+	if tmdb.client == nil {
+		tmdb.client = defaultHTTPClient
+	}
+
+	if config.RateLimit > 0 {
+		tmdb.limiter = newRateLimiter(config.RateLimit, config.RateBurst)
+	}
+
+	if config.Cache != nil {
+		tmdb.cache = newResponseCache(config.Cache, config.CacheTTLs)
+	}
+
+	if !config.DisableCoalescing {
+		tmdb.inflight = newInflightGroup()
 	}
 
-	return &TMDb{apiKey: config.APIKey}
+	tmdb.baseURL = strings.TrimSuffix(config.BaseURL, "/")
+	if tmdb.baseURL == "" {
+		tmdb.baseURL = defaultBaseURL
+	}
+
+	tmdb.v4BaseURL = strings.TrimSuffix(config.V4BaseURL, "/")
+	if tmdb.v4BaseURL == "" {
+		tmdb.v4BaseURL = strings.TrimSuffix(tmdb.baseURL, "/3") + "/4"
+	}
+
+	tmdb.imageBaseURL = config.ImageBaseURL
+	if tmdb.imageBaseURL != "" && !strings.HasSuffix(tmdb.imageBaseURL, "/") {
+		tmdb.imageBaseURL += "/"
+	}
+
+	return tmdb
+}
+
+// WithContext returns a shallow copy of tmdb whose requests are bound to ctx.
+// Cancelling ctx, or reaching its deadline, aborts any in-flight call made
+// through the returned value. The original value is left untouched.
+func (tmdb *TMDb) WithContext(ctx context.Context) *TMDb {
+	if ctx == nil {
+		panic("nil context")
+	}
+	clone := *tmdb
+	clone.ctx = ctx
+	return &clone
+}
+
+// Context returns the context requests are bound to, defaulting to
+// context.Background when none has been set with WithContext.
+func (tmdb *TMDb) Context() context.Context {
+	if tmdb.ctx != nil {
+		return tmdb.ctx
+	}
+	return context.Background()
 }
 
 // ToJSON converts from struct to JSON
@@ -62,60 +196,191 @@ func ToJSON(payload interface{}) (string, error) {
 	return string(jsonRes), err
 }
 
-func getTmdb(url string, payload interface{}) (interface{}, error) {
-	var httpRequest http.Client
+func (tmdb *TMDb) getTmdb(url string, payload interface{}) (interface{}, error) {
+	var res *response
+	var err error
+	if tmdb.inflight != nil && !isPrivateRequest(tmdb.baseURL, url) {
+		res, err = tmdb.inflight.do(tmdb.Context(), url, func() (*response, error) {
+			return tmdb.cachedGet(url)
+		})
+	} else {
+		res, err = tmdb.cachedGet(url)
+	}
+	if err != nil {
+		return payload, err
+	}
+	return payload, res.decode(payload)
+}
 
-	if internalConfig.useProxy {
-		roundRobin := internalConfig.roundRobin.GetTicker()
-		proxy := internalConfig.proxies[roundRobin]
+func (tmdb *TMDb) postTmdb(url string, body, payload interface{}) (interface{}, error) {
+	return tmdb.sendTmdb(http.MethodPost, url, body, payload)
+}
 
-		if proxy.Host == "localhost" {
-			httpRequest = getHTTPClient()
-		} else {
-			httpRequest = getHTTPClientWithProxy(proxy)
+func (tmdb *TMDb) deleteTmdb(url string, body, payload interface{}) (interface{}, error) {
+	return tmdb.sendTmdb(http.MethodDelete, url, body, payload)
+}
+
+// sendTmdb sends body, when not nil, as JSON. Requests sent this way are
+// never cached or coalesced, and only GET requests are retried.
+func (tmdb *TMDb) sendTmdb(method, url string, body, payload interface{}) (interface{}, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return payload, err
 		}
-	} else {
-		httpRequest = getHTTPClient()
 	}
-
-	res, err := httpRequest.Get(url)
-	if err != nil { // HTTP connection error
+	res, err := tmdb.send(method, url, data)
+	if err != nil {
 		return payload, err
 	}
+	return payload, res.decode(payload)
+}
+
+// v4Tmdb sends a request to the v4 API. A non-empty accessToken replaces the
+// configured read access token, so users can act on their own resources.
+// Since responses may be private to the token, they bypass the cache and
+// coalescing. Without any token it fails with ErrAccessTokenRequired.
+func (tmdb *TMDb) v4Tmdb(method, path string, query url.Values, accessToken string, body, payload interface{}) (interface{}, error) {
+	clone := *tmdb
+	if accessToken != "" {
+		clone.accessToken = accessToken
+	}
+	if clone.accessToken == "" {
+		return payload, ErrAccessTokenRequired
+	}
+	uri := clone.v4BaseURL + path
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
+	}
+	return clone.sendTmdb(method, uri, body, payload)
+}
+
+// response is a fully read HTTP response
+type response struct {
+	statusCode int
+	header     http.Header
+	body       []byte
+	path       string
+}
+
+// send performs a request, retrying it according to the retry policy
+func (tmdb *TMDb) send(method, url string, body []byte) (*response, error) {
+	for attempt := 1; ; attempt++ {
+		res, err := tmdb.do(method, url, body)
+		if method != http.MethodGet || attempt >= tmdb.retry.MaxAttempts || !tmdb.shouldRetry(res, err) {
+			return res, err
+		}
+		if err := sleep(tmdb.Context(), tmdb.retry.backoff(attempt, res)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// do performs a single attempt of a request, with body as its JSON payload
+func (tmdb *TMDb) do(method, url string, body []byte) (*response, error) {
+	if tmdb.limiter != nil {
+		if err := tmdb.limiter.wait(tmdb.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	httpRequest := tmdb.client
+	if tmdb.useProxy {
+		roundRobin := tmdb.roundRobin.GetTicker()
+		httpRequest = tmdb.proxyClients[roundRobin]
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(tmdb.Context(), method, url, reader)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json;charset=utf-8")
+	}
+	tmdb.authorize(req)
+
+	res, err := httpRequest.Do(req)
+	if err != nil { // HTTP connection error or cancelled context
+		return nil, err
+	}
 
 	defer res.Body.Close() // Clean up
 
-	body, err := io.ReadAll(res.Body)
+	resBody, err := io.ReadAll(res.Body)
 	if err != nil { // Failed to read body
-		return payload, err
+		return nil, err
 	}
 
-	if res.StatusCode >= 200 && res.StatusCode < 300 { // Success!
-		err := json.Unmarshal(body, &payload)
+	return &response{
+		statusCode: res.StatusCode,
+		header:     res.Header,
+		body:       resBody,
+		path:       req.URL.Path,
+	}, nil
+}
+
+// decode unmarshals a successful response into payload, or turns a failed
+// one into an *APIError
+func (res *response) decode(payload interface{}) error {
+	if res.statusCode >= 200 && res.statusCode < 300 { // Success!
+		err := json.Unmarshal(res.body, payload)
 		if err != nil {
-			return payload, fmt.Errorf("unmarshaling payload (status code %d): %w", res.StatusCode, err)
+			return fmt.Errorf("unmarshaling payload (status code %d): %w", res.statusCode, err)
 		}
-		return payload, nil
+		return nil
 	}
 
 	// Handle failure modes
+	apiErr := &APIError{StatusCode: res.statusCode, Path: res.path}
 	var status apiStatus
-	err = json.Unmarshal(body, &status)
-	if err != nil {
-		return payload, fmt.Errorf("unmarshaling error payload (status code %d) yield response '%s': %w", res.StatusCode, string(body), err)
+	if err := json.Unmarshal(res.body, &status); err == nil {
+		apiErr.Code = status.Code
+		apiErr.Message = status.Message
+	} else {
+		apiErr.Message = strings.TrimSpace(string(res.body))
 	}
-	return payload, fmt.Errorf("code (%d): %s", status.Code, status.Message)
+	return apiErr
 }
 
-func getOptionsString(options map[string]string, availableOptions map[string]struct{}) string {
-	var optionsString = ""
+// authorize adds the credentials to req: the read access token as a bearer
+// header when one is configured, the api_key query parameter otherwise.
+func (tmdb *TMDb) authorize(req *http.Request) {
+	req.Header.Set("Accept", "application/json")
+	if tmdb.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+tmdb.accessToken)
+		return
+	}
+	query := req.URL.Query()
+	query.Set("api_key", tmdb.apiKey)
+	req.URL.RawQuery = query.Encode()
+}
+
+// getOptions turns options into query parameters. Options the endpoint does
+// not support are rejected with ErrUnsupportedOption.
+func getOptions(options map[string]string, availableOptions map[string]struct{}) (url.Values, error) {
+	query := make(url.Values, len(options))
 	for key, val := range options {
-		if _, ok := availableOptions[key]; ok {
-			newString := fmt.Sprintf("%s&%s=%s", optionsString, key, val)
-			optionsString = newString
+		if _, ok := availableOptions[key]; !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnsupportedOption, key)
 		}
+		query.Set(key, val)
+	}
+	return query, nil
+}
+
+// buildURL joins path to the base URL and appends the escaped query. Path
+// segments coming from callers must be escaped with url.PathEscape.
+func (tmdb *TMDb) buildURL(path string, query url.Values) string {
+	uri := tmdb.baseURL + path
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
 	}
-	return optionsString
+	return uri
 }
 
 func prepareProxies(proxies []Proxy) []Proxy {
@@ -124,20 +389,41 @@ func prepareProxies(proxies []Proxy) []Proxy {
 	return preparedProxies
 }
 
-func getHTTPClient() http.Client {
-	return http.Client{
-		Transport: &http.Transport{},
+// prepareProxyClients builds one client per proxy up front, so each proxy
+// keeps its own pool of connections across requests.
+func prepareProxyClients(proxies []Proxy) []*http.Client {
+	clients := make([]*http.Client, len(proxies))
+	for i, proxy := range proxies {
+		if proxy.Host == "localhost" {
+			clients[i] = defaultHTTPClient
+		} else {
+			clients[i] = getHTTPClientWithProxy(proxy)
+		}
 	}
+	return clients
 }
 
-func getHTTPClientWithProxy(proxy Proxy) http.Client {
-	return http.Client{
-		Transport: &http.Transport{
-			Proxy: http.ProxyURL(makeProxyURL(proxy)),
-		},
+func newTransport() *http.Transport {
+	return &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		MaxIdleConnsPerHost:   20, // Every request goes to the same host
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
 	}
 }
 
+func getHTTPClientWithProxy(proxy Proxy) *http.Client {
+	transport := newTransport()
+	transport.Proxy = http.ProxyURL(makeProxyURL(proxy))
+	return &http.Client{Transport: transport}
+}
+
 func makeProxyURL(proxy Proxy) *url.URL {
 	proxyURL := ""
 	if proxy.Auth {
@@ -1,11 +1,20 @@
 package tmdb
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/kylelemons/go-gypsy/yaml"
 	. "gopkg.in/check.v1"
@@ -58,3 +67,351 @@ func (s *TmdbSuite) baseTest(input interface{}, err error, c *C) {
 	c.Assert(err, IsNil)
 	c.Assert(jsonRes, NotNil)
 }
+
+// LocalSuite runs against an httptest.Server instead of the live API, so it
+// needs neither network access nor a local.yml file.
+type LocalSuite struct{}
+
+var _ = Suite(&LocalSuite{})
+
+// newServer starts a stand-in for the API and returns a client pointed at it.
+func (s *LocalSuite) newServer(c *C, handler http.HandlerFunc) (*TMDb, *httptest.Server) {
+	server := httptest.NewServer(handler)
+	c.Assert(server, NotNil)
+	return Init(Config{APIKey: "key", BaseURL: server.URL}), server
+}
+
+// writeRequest is a request seen by newWriteServer, with its decoded JSON body
+type writeRequest struct {
+	method      string
+	path        string
+	query       url.Values
+	contentType string
+	body        map[string]interface{}
+}
+
+// newWriteServer records every request and answers it with a success status.
+func (s *LocalSuite) newWriteServer(c *C) (*TMDb, *httptest.Server, *[]writeRequest) {
+	var requests []writeRequest
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		req := writeRequest{
+			method:      r.Method,
+			path:        r.URL.Path,
+			query:       r.URL.Query(),
+			contentType: r.Header.Get("Content-Type"),
+		}
+		if r.ContentLength > 0 {
+			c.Check(json.NewDecoder(r.Body).Decode(&req.body), IsNil)
+		}
+		requests = append(requests, req)
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"success":true,"status_code":1,"status_message":"Success."}`))
+	})
+	return tmdb, server, &requests
+}
+
+func (s *LocalSuite) TestWithContextCancelsInFlightRequest(c *C) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+
+	api := Init(Config{APIKey: "key"})
+	var movie Movie
+	_, err := api.WithContext(ctx).getTmdb(server.URL+"/movie/550", &movie)
+	c.Assert(errors.Is(err, context.Canceled), Equals, true)
+}
+
+func (s *LocalSuite) TestWithContextDeadline(c *C) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	api := Init(Config{APIKey: "key"})
+	var movie Movie
+	_, err := api.WithContext(ctx).getTmdb(server.URL+"/movie/550", &movie)
+	c.Assert(errors.Is(err, context.DeadlineExceeded), Equals, true)
+}
+
+func (s *LocalSuite) TestWithContextLeavesOriginalUntouched(c *C) {
+	api := Init(Config{APIKey: "key"})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	bound := api.WithContext(ctx)
+	c.Assert(bound.Context(), Equals, ctx)
+	c.Assert(api.Context(), Equals, context.Background())
+	c.Assert(bound.apiKey, Equals, api.apiKey)
+}
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func jsonResponse(req *http.Request, status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Request:    req,
+	}
+}
+
+func (s *LocalSuite) TestConfigTransportIsUsedForEveryRequest(c *C) {
+	calls := 0
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return jsonResponse(req, http.StatusOK, `{"id": 550, "title": "Fight Club"}`), nil
+	})
+	api := Init(Config{APIKey: "key", Transport: transport})
+
+	for i := 0; i < 3; i++ {
+		var movie Movie
+		_, err := api.getTmdb("http://tmdb.invalid/movie/550", &movie)
+		c.Assert(err, IsNil)
+		c.Assert(movie.Title, Equals, "Fight Club")
+	}
+	c.Assert(calls, Equals, 3)
+}
+
+func (s *LocalSuite) TestConfigHTTPClientTakesPrecedence(c *C) {
+	client := &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return jsonResponse(req, http.StatusOK, `{"id": 550}`), nil
+	})}
+	unused := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		c.Fatalf("Transport used although HTTPClient was set")
+		return nil, nil
+	})
+	api := Init(Config{APIKey: "key", HTTPClient: client, Transport: unused})
+	c.Assert(api.client, Equals, client)
+
+	var movie Movie
+	_, err := api.getTmdb("http://tmdb.invalid/movie/550", &movie)
+	c.Assert(err, IsNil)
+	c.Assert(movie.ID, Equals, 550)
+}
+
+func (s *LocalSuite) TestDefaultHTTPClientIsShared(c *C) {
+	first := Init(Config{APIKey: "first"})
+	second := Init(Config{APIKey: "second"})
+	c.Assert(first.client, Equals, defaultHTTPClient)
+	c.Assert(second.client, Equals, defaultHTTPClient)
+}
+
+func (s *LocalSuite) TestConfigBaseURL(c *C) {
+	var paths []string
+	api, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprint(w, `{"id": 550, "title": "Fight Club"}`)
+	})
+	defer server.Close()
+
+	movie, err := api.GetMovieInfo(550, nil)
+	c.Assert(err, IsNil)
+	c.Assert(movie.Title, Equals, "Fight Club")
+	c.Assert(paths, DeepEquals, []string{"/movie/550"})
+}
+
+func (s *LocalSuite) TestConfigBaseURLDefault(c *C) {
+	c.Assert(Init(Config{}).baseURL, Equals, defaultBaseURL)
+	c.Assert(Init(Config{BaseURL: "http://mirror.local/3/"}).baseURL, Equals, "http://mirror.local/3")
+}
+
+func (s *LocalSuite) TestInstancesDoNotShareConfiguration(c *C) {
+	newServer := func(key string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Query().Get("api_key") != key {
+				w.WriteHeader(http.StatusUnauthorized)
+				fmt.Fprint(w, `{"status_code": 7, "status_message": "Invalid API key"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"id": 550, "title": %q}`, key)
+		}))
+	}
+	first, second := newServer("first"), newServer("second")
+	defer first.Close()
+	defer second.Close()
+
+	proxied := Init(Config{
+		APIKey:   "first",
+		BaseURL:  first.URL,
+		UseProxy: true,
+		Proxies:  []Proxy{{Host: "localhost"}, {Host: "localhost"}},
+	})
+	direct := Init(Config{APIKey: "second", BaseURL: second.URL})
+
+	c.Assert(proxied.useProxy, Equals, true)
+	c.Assert(proxied.proxyClients, HasLen, 2)
+	c.Assert(direct.useProxy, Equals, false)
+	c.Assert(direct.roundRobin, IsNil)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		for _, api := range []*TMDb{proxied, direct} {
+			wg.Add(1)
+			go func(api *TMDb) {
+				defer wg.Done()
+				movie, err := api.GetMovieInfo(550, nil)
+				c.Check(err, IsNil)
+				c.Check(movie.Title, Equals, api.apiKey)
+			}(api)
+		}
+	}
+	wg.Wait()
+}
+
+func (s *LocalSuite) TestProxiesAreUsedInTurn(c *C) {
+	var mu sync.Mutex
+	hits := map[string]int{}
+	recording := func(name string) *http.Client {
+		return &http.Client{Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			hits[name]++
+			mu.Unlock()
+			return jsonResponse(req, http.StatusOK, `{"id": 550}`), nil
+		})}
+	}
+
+	api := Init(Config{
+		APIKey:   "key",
+		UseProxy: true,
+		Proxies:  []Proxy{{Host: "first.local", Port: "8080"}, {Host: "second.local", Port: "8080"}},
+	})
+	api.proxyClients = []*http.Client{recording("first"), recording("second")}
+
+	for i := 0; i < 4; i++ {
+		_, err := api.WithContext(context.Background()).GetMovieInfo(550, nil)
+		c.Assert(err, IsNil)
+	}
+	c.Assert(hits, DeepEquals, map[string]int{"first": 2, "second": 2})
+}
+
+func (s *LocalSuite) TestReadAccessTokenIsSentAsBearer(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Header.Get("Authorization"), Equals, "Bearer token")
+		c.Check(r.URL.Query().Has("api_key"), Equals, false)
+		c.Check(r.URL.Query().Get("language"), Equals, "es")
+		fmt.Fprint(w, `{"id": 550}`)
+	}))
+	defer server.Close()
+
+	api := Init(Config{APIKey: "key", ReadAccessToken: "token", BaseURL: server.URL})
+	movie, err := api.GetMovieInfo(550, map[string]string{"language": "es"})
+	c.Assert(err, IsNil)
+	c.Assert(movie.ID, Equals, 550)
+}
+
+func (s *LocalSuite) TestAPIKeyIsSentAsQuery(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.Header.Get("Authorization"), Equals, "")
+		c.Check(r.URL.Query().Get("api_key"), Equals, "key")
+		fmt.Fprint(w, `{"id": 550}`)
+	}))
+	defer server.Close()
+
+	api := Init(Config{APIKey: "key", BaseURL: server.URL})
+	_, err := api.GetMovieInfo(550, nil)
+	c.Assert(err, IsNil)
+}
+
+func (s *LocalSuite) TestOptionValuesAreEscaped(c *C) {
+	tests := []struct {
+		key   string
+		value string
+		raw   string
+	}{
+		{"with_genres", "28|12", "with_genres=28%7C12"},
+		{"with_genres", "28,12", "with_genres=28%2C12"},
+		{"append_to_response", "credits,images", "append_to_response=credits%2Cimages"},
+		{"language", "en&page=2", "language=en%26page%3D2"},
+		{"language", "pt BR", "language=pt+BR"},
+		{"language", "a+b", "language=a%2Bb"},
+	}
+	for _, test := range tests {
+		var received string
+		api, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+			received = r.URL.Query().Get(test.key)
+			c.Check(strings.Contains(r.URL.RawQuery, test.raw), Equals, true, Commentf(r.URL.RawQuery))
+			fmt.Fprint(w, `{}`)
+		})
+		available := map[string]struct{}{test.key: {}}
+		query, err := getOptions(map[string]string{test.key: test.value}, available)
+		c.Assert(err, IsNil)
+		_, err = api.getTmdb(api.buildURL("/discover/movie", query), &MoviePagedResults{})
+		c.Assert(err, IsNil)
+		c.Check(received, Equals, test.value)
+		server.Close()
+	}
+}
+
+func (s *LocalSuite) TestRequestValuesAreEscaped(c *C) {
+	tests := []struct {
+		call  func(api *TMDb) error
+		path  string
+		query url.Values
+	}{
+		{
+			call: func(api *TMDb) error {
+				_, err := api.SearchMovie("Fast & Furious + 2", nil)
+				return err
+			},
+			path:  "/search/movie",
+			query: url.Values{"query": {"Fast & Furious + 2"}},
+		},
+		{
+			call: func(api *TMDb) error {
+				_, err := api.GetListInfo("a/b c?d")
+				return err
+			},
+			path: "/list/a%2Fb%20c%3Fd",
+		},
+		{
+			call: func(api *TMDb) error {
+				_, err := api.GetFind("tt0137523&x=1", "imdb_id", nil)
+				return err
+			},
+			path:  "/find/tt0137523&x=1",
+			query: url.Values{"external_source": {"imdb_id"}},
+		},
+	}
+	for _, test := range tests {
+		api, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+			c.Check(r.URL.EscapedPath(), Equals, test.path)
+			query := r.URL.Query()
+			c.Check(query.Get("api_key"), Equals, "key")
+			query.Del("api_key")
+			if test.query == nil {
+				test.query = url.Values{}
+			}
+			c.Check(query, DeepEquals, test.query)
+			fmt.Fprint(w, `{}`)
+		})
+		c.Check(test.call(api), IsNil)
+		server.Close()
+	}
+}
@@ -2,6 +2,8 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
+	"time"
 )
 
 // Movie struct
@@ -59,6 +61,8 @@ type Movie struct {
 	Changes           *MovieChanges           `json:",omitempty"`
 	Rating            *MovieRating            `json:",omitempty"`
 	ExternalIDs       *MovieExternalIds       `json:"external_ids,omitempty"`
+	WatchProviders    *WatchProviders         `json:"watch/providers,omitempty"`
+	ReleaseDates      *MovieReleaseDates      `json:"release_dates,omitempty"`
 }
 
 // MovieShort struct
@@ -269,17 +273,9 @@ type MovieKeywords struct {
 
 // MovieLists struct
 type MovieLists struct {
-	ID      int
-	Page    int
-	Results []struct {
-		Description   string
-		FavoriteCount int `json:"favorite_count"`
-		ID            int
-		ItemCount     int    `json:"item_count"`
-		Iso639_1      string `json:"iso_639_1"`
-		Name          string
-		PosterPath    string `json:"poster_path"`
-	}
+	ID                int
+	Page              int
+	Results           []MovieList
 	TotalPages        int                     `json:"total_pages"`
 	TotalResults      int                     `json:"total_results"`
 	AlternativeTitles *MovieAlternativeTitles `json:"alternative_titles,omitempty"`
@@ -295,12 +291,127 @@ type MovieLists struct {
 	Rating            *MovieRating            `json:",omitempty"`
 }
 
+// MovieList struct
+type MovieList struct {
+	Description   string
+	FavoriteCount int `json:"favorite_count"`
+	ID            int
+	ItemCount     int    `json:"item_count"`
+	Iso639_1      string `json:"iso_639_1"`
+	Name          string
+	PosterPath    string `json:"poster_path"`
+}
+
 // MovieRating struct
 type MovieRating struct {
 	StatusCode    int    `json:"status_code"`
 	StatusMessage string `json:"status_message"`
 }
 
+// ReleaseType type
+type ReleaseType int
+
+// Release types, as used by the release dates of a movie
+const (
+	ReleasePremiere          ReleaseType = 1
+	ReleaseTheatricalLimited ReleaseType = 2
+	ReleaseTheatrical        ReleaseType = 3
+	ReleaseDigital           ReleaseType = 4
+	ReleasePhysical          ReleaseType = 5
+	ReleaseTV                ReleaseType = 6
+)
+
+var releaseTypeNames = map[ReleaseType]string{
+	ReleasePremiere:          "Premiere",
+	ReleaseTheatricalLimited: "Theatrical (limited)",
+	ReleaseTheatrical:        "Theatrical",
+	ReleaseDigital:           "Digital",
+	ReleasePhysical:          "Physical",
+	ReleaseTV:                "TV",
+}
+
+// String returns the name of the release type
+func (t ReleaseType) String() string {
+	if name, ok := releaseTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("ReleaseType(%d)", int(t))
+}
+
+// MovieReleaseDates struct
+type MovieReleaseDates struct {
+	ID      int `json:",omitempty"`
+	Results []MovieReleaseDatesCountry
+}
+
+// MovieReleaseDatesCountry struct
+type MovieReleaseDatesCountry struct {
+	Iso3166_1    string             `json:"iso_3166_1"`
+	ReleaseDates []MovieReleaseDate `json:"release_dates"`
+}
+
+// MovieReleaseDate struct
+type MovieReleaseDate struct {
+	Certification string
+	Descriptors   []string
+	Iso639_1      string `json:"iso_639_1"`
+	Note          string
+	ReleaseDate   string `json:"release_date"`
+	Type          ReleaseType
+}
+
+// Date parses ReleaseDate, which is either a full timestamp or a bare date. It
+// returns the zero time when the date is missing or malformed.
+func (release *MovieReleaseDate) Date() time.Time {
+	for _, layout := range []string{time.RFC3339, "2006-01-02"} {
+		if date, err := time.Parse(layout, release.ReleaseDate); err == nil {
+			return date
+		}
+	}
+	return time.Time{}
+}
+
+// Country returns the releases of the movie in an ISO 3166-1 country
+func (releases *MovieReleaseDates) Country(country string) []MovieReleaseDate {
+	for _, result := range releases.Results {
+		if result.Iso3166_1 == country {
+			return result.ReleaseDates
+		}
+	}
+	return nil
+}
+
+// Earliest returns the earliest release of the movie in an ISO 3166-1
+// country, among the given types or among all of them when none is given.
+// Releases without a valid date are only returned when no other one matches.
+func (releases *MovieReleaseDates) Earliest(country string, types ...ReleaseType) (MovieReleaseDate, bool) {
+	var earliest MovieReleaseDate
+	var earliestDate time.Time
+	found := false
+	for _, release := range releases.Country(country) {
+		if !hasReleaseType(types, release.Type) {
+			continue
+		}
+		date := release.Date()
+		if !found || (!date.IsZero() && (earliestDate.IsZero() || date.Before(earliestDate))) {
+			earliest, earliestDate, found = release, date, true
+		}
+	}
+	return earliest, found
+}
+
+func hasReleaseType(types []ReleaseType, t ReleaseType) bool {
+	if len(types) == 0 {
+		return true
+	}
+	for _, candidate := range types {
+		if candidate == t {
+			return true
+		}
+	}
+	return false
+}
+
 // MovieReleases struct
 type MovieReleases struct {
 	ID        int
@@ -324,14 +435,9 @@ type MovieReleases struct {
 
 // MovieReviews struct
 type MovieReviews struct {
-	ID      int
-	Page    int
-	Results []struct {
-		ID      string
-		Author  string
-		Content string
-		URL     string
-	}
+	ID                int
+	Page              int
+	Results           []MovieReview
 	TotalPages        int                     `json:"total_pages"`
 	TotalResults      int                     `json:"total_results"`
 	AlternativeTitles *MovieAlternativeTitles `json:"alternative_titles,omitempty"`
@@ -347,6 +453,14 @@ type MovieReviews struct {
 	Rating            *MovieRating            `json:",omitempty"`
 }
 
+// MovieReview struct
+type MovieReview struct {
+	ID      string
+	Author  string
+	Content string
+	URL     string
+}
+
 // MovieTranslations struct
 type MovieTranslations struct {
 	ID           int
@@ -376,25 +490,28 @@ type MovieTranslations struct {
 
 // MovieRecommendations struct for movie recommendations.
 type MovieRecommendations struct {
-	Page    int `json:"page"`
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIDs         []int   `json:"genre_ids"`
-		ID               int     `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		ReleaseDate      string  `json:"release_date"`
-		PosterPath       string  `json:"poster_path"`
-		Popularity       float32 `json:"popularity"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteAverage      float32 `json:"vote_average"`
-		VoteCount        uint32  `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int                   `json:"page"`
+	Results      []MovieRecommendation `json:"results"`
+	TotalPages   int                   `json:"total_pages"`
+	TotalResults int                   `json:"total_results"`
+}
+
+// MovieRecommendation struct
+type MovieRecommendation struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIDs         []int   `json:"genre_ids"`
+	ID               int     `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	ReleaseDate      string  `json:"release_date"`
+	PosterPath       string  `json:"poster_path"`
+	Popularity       float32 `json:"popularity"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	VoteAverage      float32 `json:"vote_average"`
+	VoteCount        uint32  `json:"vote_count"`
 }
 
 // MovieVideos struct
@@ -429,9 +546,12 @@ func (tmdb *TMDb) GetMovieInfo(id int, options map[string]string) (*Movie, error
 		"language":           {},
 		"append_to_response": {}}
 	var movie Movie
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &movie)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v", id), query)
+	result, err := tmdb.getTmdb(uri, &movie)
 	return result.(*Movie), err
 }
 
@@ -439,8 +559,8 @@ func (tmdb *TMDb) GetMovieInfo(id int, options map[string]string) (*Movie, error
 // https://developers.themoviedb.org/3/movies/get-movie-account-states
 func (tmdb *TMDb) GetMovieAccountStates(id int, sessionID string) (*MovieAccountState, error) {
 	var state MovieAccountState
-	uri := fmt.Sprintf("%s/movie/%v/account_states?api_key=%s&session_id=%s", baseURL, id, tmdb.apiKey, sessionID)
-	result, err := getTmdb(uri, &state)
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/account_states", id), url.Values{"session_id": {sessionID}})
+	result, err := tmdb.getTmdb(uri, &state)
 	return result.(*MovieAccountState), err
 }
 
@@ -451,9 +571,12 @@ func (tmdb *TMDb) GetMovieAlternativeTitles(id int, options map[string]string) (
 		"country":            {},
 		"append_to_response": {}}
 	var titles MovieAlternativeTitles
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/alternative_titles?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &titles)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/alternative_titles", id), query)
+	result, err := tmdb.getTmdb(uri, &titles)
 	return result.(*MovieAlternativeTitles), err
 }
 
@@ -464,9 +587,12 @@ func (tmdb *TMDb) GetMovieChanges(id int, options map[string]string) (*MovieChan
 		"start_date": {},
 		"end_date":   {}}
 	var changes MovieChanges
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/changes?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &changes)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/changes", id), query)
+	result, err := tmdb.getTmdb(uri, &changes)
 	return result.(*MovieChanges), err
 }
 
@@ -476,9 +602,12 @@ func (tmdb *TMDb) GetMovieCredits(id int, options map[string]string) (*MovieCred
 	var availableOptions = map[string]struct{}{
 		"append_to_response": {}}
 	var credits MovieCredits
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/credits?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &credits)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/credits", id), query)
+	result, err := tmdb.getTmdb(uri, &credits)
 	return result.(*MovieCredits), err
 }
 
@@ -490,9 +619,12 @@ func (tmdb *TMDb) GetMovieImages(id int, options map[string]string) (*MovieImage
 		"append_to_response":     {},
 		"include_image_language": {}}
 	var images MovieImages
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/images?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &images)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/images", id), query)
+	result, err := tmdb.getTmdb(uri, &images)
 	return result.(*MovieImages), err
 }
 
@@ -502,9 +634,12 @@ func (tmdb *TMDb) GetMovieKeywords(id int, options map[string]string) (*MovieKey
 	var availableOptions = map[string]struct{}{
 		"append_to_response": {}}
 	var keywords MovieKeywords
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/keywords?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &keywords)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/keywords", id), query)
+	result, err := tmdb.getTmdb(uri, &keywords)
 	return result.(*MovieKeywords), err
 }
 
@@ -512,8 +647,8 @@ func (tmdb *TMDb) GetMovieKeywords(id int, options map[string]string) (*MovieKey
 // https://developers.themoviedb.org/3/movies/get-latest-movie
 func (tmdb *TMDb) GetMovieLatest() (*Movie, error) {
 	var movie Movie
-	uri := fmt.Sprintf("%s/movie/latest?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &movie)
+	uri := tmdb.buildURL("/movie/latest", nil)
+	result, err := tmdb.getTmdb(uri, &movie)
 	return result.(*Movie), err
 }
 
@@ -525,9 +660,12 @@ func (tmdb *TMDb) GetMovieLists(id int, options map[string]string) (*MovieLists,
 		"language":           {},
 		"append_to_response": {}}
 	var lists MovieLists
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/lists?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &lists)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/lists", id), query)
+	result, err := tmdb.getTmdb(uri, &lists)
 	return result.(*MovieLists), err
 }
 
@@ -538,9 +676,12 @@ func (tmdb *TMDb) GetMovieNowPlaying(options map[string]string) (*MovieDatedResu
 		"page":     {},
 		"language": {}}
 	var nowPlaying MovieDatedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/now_playing?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &nowPlaying)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/movie/now_playing", query)
+	result, err := tmdb.getTmdb(uri, &nowPlaying)
 	return result.(*MovieDatedResults), err
 }
 
@@ -551,24 +692,42 @@ func (tmdb *TMDb) GetMoviePopular(options map[string]string) (*MoviePagedResults
 		"page":     {},
 		"language": {}}
 	var popular MoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/popular?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &popular)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/movie/popular", query)
+	result, err := tmdb.getTmdb(uri, &popular)
 	return result.(*MoviePagedResults), err
 }
 
 // GetMovieReleases for a specific movie id
 // https://developers.themoviedb.org/3/movies/get-movie-release-dates
+//
+// Deprecated: TMDb deprecated the /movie/{id}/releases endpoint. Use
+// GetMovieReleaseDates, which also reports the type of each release.
 func (tmdb *TMDb) GetMovieReleases(id int, options map[string]string) (*MovieReleases, error) {
 	var availableOptions = map[string]struct{}{
 		"append_to_response": {}}
 	var releases MovieReleases
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/releases?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &releases)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/releases", id), query)
+	result, err := tmdb.getTmdb(uri, &releases)
 	return result.(*MovieReleases), err
 }
 
+// GetMovieReleaseDates gets the release dates of a movie and their type, per country
+// https://developers.themoviedb.org/3/movies/get-movie-release-dates
+func (tmdb *TMDb) GetMovieReleaseDates(id int) (*MovieReleaseDates, error) {
+	var releases MovieReleaseDates
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/release_dates", id), nil)
+	result, err := tmdb.getTmdb(uri, &releases)
+	return result.(*MovieReleaseDates), err
+}
+
 // GetMovieReviews for a specific movie id
 // https://developers.themoviedb.org/3/movies/get-movie-reviews
 func (tmdb *TMDb) GetMovieReviews(id int, options map[string]string) (*MovieReviews, error) {
@@ -577,9 +736,12 @@ func (tmdb *TMDb) GetMovieReviews(id int, options map[string]string) (*MovieRevi
 		"language":           {},
 		"append_to_response": {}}
 	var reviews MovieReviews
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/reviews?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &reviews)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/reviews", id), query)
+	result, err := tmdb.getTmdb(uri, &reviews)
 	return result.(*MovieReviews), err
 }
 
@@ -591,9 +753,12 @@ func (tmdb *TMDb) GetMovieSimilar(id int, options map[string]string) (*MoviePage
 		"language":           {},
 		"append_to_response": {}}
 	var similar MoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/similar?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &similar)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/similar", id), query)
+	result, err := tmdb.getTmdb(uri, &similar)
 	return result.(*MoviePagedResults), err
 }
 
@@ -604,9 +769,12 @@ func (tmdb *TMDb) GetMovieTopRated(options map[string]string) (*MoviePagedResult
 		"page":     {},
 		"language": {}}
 	var topRated MoviePagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/top_rated?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &topRated)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/movie/top_rated", query)
+	result, err := tmdb.getTmdb(uri, &topRated)
 	return result.(*MoviePagedResults), err
 }
 
@@ -616,9 +784,12 @@ func (tmdb *TMDb) GetMovieTranslations(id int, options map[string]string) (*Movi
 	var availableOptions = map[string]struct{}{
 		"append_to_response": {}}
 	var translations MovieTranslations
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/translations?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &translations)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/translations", id), query)
+	result, err := tmdb.getTmdb(uri, &translations)
 	return result.(*MovieTranslations), err
 }
 
@@ -629,9 +800,12 @@ func (tmdb *TMDb) GetMovieRecommendations(id int, options map[string]string) (*M
 		"language": {},
 		"page":     {}}
 	var movieRec MovieRecommendations
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/recommendations?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &movieRec)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/recommendations", id), query)
+	result, err := tmdb.getTmdb(uri, &movieRec)
 	return result.(*MovieRecommendations), err
 }
 
@@ -642,9 +816,12 @@ func (tmdb *TMDb) GetMovieVideos(id int, options map[string]string) (*MovieVideo
 		"language":           {},
 		"append_to_response": {}}
 	var videos MovieVideos
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/%v/videos?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &videos)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/videos", id), query)
+	result, err := tmdb.getTmdb(uri, &videos)
 	return result.(*MovieVideos), err
 }
 
@@ -655,27 +832,57 @@ func (tmdb *TMDb) GetMovieUpcoming(options map[string]string) (*MovieDatedResult
 		"page":     {},
 		"language": {}}
 	var upcoming MovieDatedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/movie/upcoming?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &upcoming)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/movie/upcoming", query)
+	result, err := tmdb.getTmdb(uri, &upcoming)
 	return result.(*MovieDatedResults), err
 }
 
 // GetMovieExternalIds gets the external ids for a movie
 // https://developers.themoviedb.org/3/movies/get-movie-external-ids
 func (tmdb *TMDb) GetMovieExternalIds(movieID int, options map[string]string) (*MovieExternalIds, error) {
-	// currently there are not options, left it so it may be updated in the future without breaking existing code
 	var ids MovieExternalIds
-	uri := fmt.Sprintf("%s/movie/%v/external_ids?api_key=%s", baseURL, movieID, tmdb.apiKey)
-	result, err := getTmdb(uri, &ids)
+	query, err := getOptions(options, map[string]struct{}{})
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/external_ids", movieID), query)
+	result, err := tmdb.getTmdb(uri, &ids)
 	return result.(*MovieExternalIds), err
 }
 
-// // SetMovieRating lets users rate a movie
-// // https://developers.themoviedb.org/3/movies/rate-movie
-// func (tmdb *TMDb) SetMovieRating(id int) (*MovieRating, error) {
-// 	// TODO
-// 	var rating MovieRating
-// 	var err error
-// 	return &rating, err
-// }
+// GetMovieWatchProviders gets where a movie can be streamed, rented or bought, per country
+// https://developers.themoviedb.org/3/movies/get-movie-watch-providers
+func (tmdb *TMDb) GetMovieWatchProviders(id int) (*WatchProviders, error) {
+	var providers WatchProviders
+	uri := tmdb.buildURL(fmt.Sprintf("/movie/%v/watch/providers", id), nil)
+	result, err := tmdb.getTmdb(uri, &providers)
+	return result.(*WatchProviders), err
+}
+
+// RateMovie lets users rate a movie, from 0.5 to 10 in steps of 0.5
+// https://developers.themoviedb.org/3/movies/rate-movie
+func (tmdb *TMDb) RateMovie(id int, sessionID string, value float32) (*Status, error) {
+	return tmdb.rate(fmt.Sprintf("/movie/%v/rating", id), url.Values{"session_id": {sessionID}}, value)
+}
+
+// DeleteMovieRating removes the rating of a movie
+// https://developers.themoviedb.org/3/movies/delete-movie-rating
+func (tmdb *TMDb) DeleteMovieRating(id int, sessionID string) (*Status, error) {
+	return tmdb.deleteRating(fmt.Sprintf("/movie/%v/rating", id), url.Values{"session_id": {sessionID}})
+}
+
+// RateMovieAsGuest lets guests rate a movie, from 0.5 to 10 in steps of 0.5
+// https://developers.themoviedb.org/3/movies/rate-movie
+func (tmdb *TMDb) RateMovieAsGuest(id int, guestSessionID string, value float32) (*Status, error) {
+	return tmdb.rate(fmt.Sprintf("/movie/%v/rating", id), url.Values{"guest_session_id": {guestSessionID}}, value)
+}
+
+// DeleteMovieRatingAsGuest removes the rating of a movie made by a guest
+// https://developers.themoviedb.org/3/movies/delete-movie-rating
+func (tmdb *TMDb) DeleteMovieRatingAsGuest(id int, guestSessionID string) (*Status, error) {
+	return tmdb.deleteRating(fmt.Sprintf("/movie/%v/rating", id), url.Values{"guest_session_id": {guestSessionID}})
+}
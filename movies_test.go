@@ -1,6 +1,10 @@
 package tmdb
 
 import (
+	"errors"
+	"net/http"
+	"time"
+
 	. "gopkg.in/check.v1"
 )
 
@@ -167,6 +171,13 @@ func (s *TmdbSuite) TestGetMovieReleases(c *C) {
 	c.Assert(result.Countries[0].ReleaseDate, Equals, "1999-10-15")
 }
 
+func (s *TmdbSuite) TestGetMovieReleaseDates(c *C) {
+	result, err := s.tmdb.GetMovieReleaseDates(fightClubID)
+	s.baseTest(&result, err, c)
+	c.Assert(result.ID, Equals, fightClubID)
+	c.Assert(result.Country("US"), Not(HasLen), 0)
+}
+
 func (s *TmdbSuite) TestGetMovieReviews(c *C) {
 	result, err := s.tmdb.GetMovieReviews(darkKnightID, nil)
 	s.baseTest(&result, err, c)
@@ -266,3 +277,76 @@ func (s *TmdbSuite) TestGetMovieExternalIds(c *C) {
 	c.Assert(result.ImdbID, Equals, fightClubImdbID)
 	c.Assert(result.FacebookID, Equals, fightClubFacebookID)
 }
+
+func (s *LocalSuite) TestRateMovie(c *C) {
+	tmdb, server, requests := s.newWriteServer(c)
+	defer server.Close()
+
+	status, err := tmdb.RateMovie(fightClubID, "session", 8.5)
+	c.Assert(err, IsNil)
+	c.Assert(status.StatusCode, Equals, 1)
+	status, err = tmdb.DeleteMovieRating(fightClubID, "session")
+	c.Assert(err, IsNil)
+	c.Assert(status.Success, Equals, true)
+
+	c.Assert(*requests, HasLen, 2)
+	c.Check((*requests)[0].method, Equals, "POST")
+	c.Check((*requests)[0].path, Equals, "/movie/550/rating")
+	c.Check((*requests)[0].query.Get("session_id"), Equals, "session")
+	c.Check((*requests)[0].body, DeepEquals, map[string]interface{}{"value": 8.5})
+	c.Check((*requests)[1].method, Equals, "DELETE")
+	c.Check((*requests)[1].path, Equals, "/movie/550/rating")
+	c.Check((*requests)[1].body, IsNil)
+
+	for _, value := range []float32{0, 0.3, 10.5, -1} {
+		_, err = tmdb.RateMovie(fightClubID, "session", value)
+		c.Check(errors.Is(err, ErrInvalidRating), Equals, true)
+	}
+	c.Assert(*requests, HasLen, 2)
+}
+
+func (s *LocalSuite) TestGetMovieReleaseDates(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		c.Check(r.URL.Path, Equals, "/movie/550/release_dates")
+		w.Write([]byte(`{"id": 550, "results": [
+			{"iso_3166_1": "DE", "release_dates": [
+				{"certification": "", "descriptors": [], "iso_639_1": "", "note": "Fantasy Filmfest", "release_date": "", "type": 1},
+				{"certification": "18", "descriptors": [], "iso_639_1": "", "note": "", "release_date": "1999-11-11T00:00:00.000Z", "type": 3}
+			]},
+			{"iso_3166_1": "US", "release_dates": [
+				{"certification": "R", "descriptors": ["Violence"], "iso_639_1": "", "note": "Venice Film Festival", "release_date": "1999-09-10T00:00:00.000Z", "type": 1},
+				{"certification": "R", "descriptors": [], "iso_639_1": "", "note": "", "release_date": "1999-10-15T00:00:00.000Z", "type": 3},
+				{"certification": "R", "descriptors": [], "iso_639_1": "", "note": "DVD", "release_date": "2000-06-06T00:00:00.000Z", "type": 5},
+				{"certification": "R", "descriptors": [], "iso_639_1": "", "note": "iTunes", "release_date": "2009-04-14T00:00:00.000Z", "type": 4},
+				{"certification": "R", "descriptors": [], "iso_639_1": "", "note": "Blu-ray", "release_date": "2009-11-17T00:00:00.000Z", "type": 5}
+			]}
+		]}`))
+	})
+	defer server.Close()
+
+	releases, err := tmdb.GetMovieReleaseDates(fightClubID)
+	c.Assert(err, IsNil)
+	c.Assert(releases.Country("US"), HasLen, 5)
+	c.Assert(releases.Country("FR"), HasLen, 0)
+
+	premiere := releases.Country("US")[0]
+	c.Assert(premiere.Type, Equals, ReleasePremiere)
+	c.Assert(premiere.Type.String(), Equals, "Premiere")
+	c.Assert(premiere.Descriptors, DeepEquals, []string{"Violence"})
+	c.Assert(premiere.Date().Equal(time.Date(1999, time.September, 10, 0, 0, 0, 0, time.UTC)), Equals, true)
+
+	earliest, ok := releases.Earliest("US")
+	c.Assert(ok, Equals, true)
+	c.Assert(earliest.Note, Equals, "Venice Film Festival")
+	earliest, ok = releases.Earliest("US", ReleaseDigital, ReleasePhysical)
+	c.Assert(ok, Equals, true)
+	c.Assert(earliest.Note, Equals, "DVD")
+	_, ok = releases.Earliest("DE", ReleaseDigital)
+	c.Assert(ok, Equals, false)
+
+	earliest, ok = releases.Earliest("DE")
+	c.Assert(ok, Equals, true)
+	c.Assert(earliest.Type, Equals, ReleaseTheatrical)
+	c.Assert(releases.Country("DE")[0].Date().IsZero(), Equals, true)
+	c.Assert((&MovieReleaseDate{ReleaseDate: "1999-11-11"}).Date().Equal(time.Date(1999, time.November, 11, 0, 0, 0, 0, time.UTC)), Equals, true)
+}
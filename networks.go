@@ -6,15 +6,17 @@ import (
 
 // Network struct
 type Network struct {
-	ID   int
-	Name string
+	ID            int
+	Name          string
+	LogoPath      string `json:"logo_path,omitempty"`
+	OriginCountry string `json:"origin_country,omitempty"`
 }
 
 // GetNetworkInfo gets the basic information about a TV network
 // https://developers.themoviedb.org/3/networks/get-network-details
 func (tmdb *TMDb) GetNetworkInfo(id int) (*Network, error) {
 	var networkInfo Network
-	uri := fmt.Sprintf("%s/network/%v?api_key=%s", baseURL, id, tmdb.apiKey)
-	result, err := getTmdb(uri, &networkInfo)
+	uri := tmdb.buildURL(fmt.Sprintf("/network/%v", id), nil)
+	result, err := tmdb.getTmdb(uri, &networkInfo)
 	return result.(*Network), err
 }
@@ -0,0 +1,121 @@
+package tmdb
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnsupportedOption is returned when an option is passed to an endpoint
+// that does not support it
+var ErrUnsupportedOption = errors.New("unsupported option")
+
+// dateFormat is the date layout used by the API
+const dateFormat = "2006-01-02"
+
+// Option sets one optional parameter of an endpoint
+type Option func(options map[string]string)
+
+// Options builds the options map taken by the endpoint methods:
+//
+//	movie, err := api.GetMovieInfo(550, tmdb.Options(
+//		tmdb.WithLanguage("es"),
+//		tmdb.WithAppendToResponse("credits", "images"),
+//	))
+func Options(opts ...Option) map[string]string {
+	options := make(map[string]string, len(opts))
+	for _, opt := range opts {
+		opt(options)
+	}
+	return options
+}
+
+// WithOption sets an arbitrary parameter, for those without a dedicated Option
+func WithOption(key, value string) Option {
+	return func(options map[string]string) {
+		options[key] = value
+	}
+}
+
+// WithLanguage sets the ISO 639-1 language of the response, e.g. "es" or "pt-BR"
+func WithLanguage(language string) Option {
+	return WithOption("language", language)
+}
+
+// WithPage selects the page of a paged endpoint, starting from 1
+func WithPage(page int) Option {
+	return WithOption("page", strconv.Itoa(page))
+}
+
+// WithAppendToResponse appends sub-requests to a details request, e.g.
+// "credits" or "images"
+func WithAppendToResponse(parts ...string) Option {
+	return WithOption("append_to_response", strings.Join(parts, ","))
+}
+
+// WithIncludeImageLanguage selects the languages of the returned images.
+// "null" selects images without text.
+func WithIncludeImageLanguage(languages ...string) Option {
+	return WithOption("include_image_language", strings.Join(languages, ","))
+}
+
+// WithIncludeAdult includes or excludes adult content
+func WithIncludeAdult(include bool) Option {
+	return WithOption("include_adult", strconv.FormatBool(include))
+}
+
+// WithSortBy sets the sort order, e.g. "created_at.desc"
+func WithSortBy(sortBy string) Option {
+	return WithOption("sort_by", sortBy)
+}
+
+// WithSortOrder sets the sort direction, "asc" or "desc"
+func WithSortOrder(order string) Option {
+	return WithOption("sort_order", order)
+}
+
+// WithStartDate restricts changes to those made on or after date
+func WithStartDate(date time.Time) Option {
+	return WithOption("start_date", date.Format(dateFormat))
+}
+
+// WithEndDate restricts changes to those made on or before date
+func WithEndDate(date time.Time) Option {
+	return WithOption("end_date", date.Format(dateFormat))
+}
+
+// WithYear filters search results by release year
+func WithYear(year int) Option {
+	return WithOption("year", strconv.Itoa(year))
+}
+
+// WithPrimaryReleaseYear filters search results by primary release year
+func WithPrimaryReleaseYear(year int) Option {
+	return WithOption("primary_release_year", strconv.Itoa(year))
+}
+
+// WithFirstAirDateYear filters TV search results by the year of the first episode
+func WithFirstAirDateYear(year int) Option {
+	return WithOption("first_air_date_year", strconv.Itoa(year))
+}
+
+// WithSearchType sets how queries are matched, "phrase" or "ngram"
+func WithSearchType(searchType string) Option {
+	return WithOption("search_type", searchType)
+}
+
+// WithTimezone sets the timezone used by the airing today endpoint
+func WithTimezone(timezone string) Option {
+	return WithOption("timezone", timezone)
+}
+
+// WithCountry sets the ISO 3166-1 country, e.g. for alternative titles
+func WithCountry(country string) Option {
+	return WithOption("country", country)
+}
+
+// WithWatchRegion sets the ISO 3166-1 country of the watch provider endpoints
+func WithWatchRegion(country string) Option {
+	return WithOption("watch_region", country)
+}
@@ -0,0 +1,64 @@
+package tmdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *LocalSuite) TestOptions(c *C) {
+	options := Options(
+		WithLanguage("es"),
+		WithPage(2),
+		WithAppendToResponse("credits", "images"),
+		WithIncludeImageLanguage("en", "null"),
+		WithIncludeAdult(false),
+		WithStartDate(time.Date(2023, time.November, 1, 12, 0, 0, 0, time.UTC)),
+		WithOption("region", "ES"),
+	)
+	c.Assert(options, DeepEquals, map[string]string{
+		"language":               "es",
+		"page":                   "2",
+		"append_to_response":     "credits,images",
+		"include_image_language": "en,null",
+		"include_adult":          "false",
+		"start_date":             "2023-11-01",
+		"region":                 "ES",
+	})
+}
+
+func (s *LocalSuite) TestUnsupportedOptionIsRejected(c *C) {
+	hits := 0
+	api, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		fmt.Fprint(w, `{"id": 550}`)
+	})
+	defer server.Close()
+
+	movie, err := api.GetMovieInfo(550, Options(WithLanguage("es"), WithPage(2)))
+	c.Assert(errors.Is(err, ErrUnsupportedOption), Equals, true)
+	c.Assert(err, ErrorMatches, `unsupported option: "page"`)
+	c.Assert(movie, IsNil)
+	c.Assert(hits, Equals, 0)
+
+	_, err = api.GetMovieExternalIds(550, Options(WithLanguage("es")))
+	c.Assert(errors.Is(err, ErrUnsupportedOption), Equals, true)
+	c.Assert(hits, Equals, 0)
+
+	_, err = api.GetMovieInfo(550, Options(WithLanguage("es"), WithAppendToResponse("credits")))
+	c.Assert(err, IsNil)
+	c.Assert(hits, Equals, 1)
+}
+
+func (s *LocalSuite) TestGetOptionsIsStable(c *C) {
+	available := map[string]struct{}{"page": {}, "language": {}, "include_adult": {}}
+	options := Options(WithPage(1), WithLanguage("es"), WithIncludeAdult(true))
+	for i := 0; i < 10; i++ {
+		query, err := getOptions(options, available)
+		c.Assert(err, IsNil)
+		c.Assert(query.Encode(), Equals, "include_adult=true&language=es&page=1")
+	}
+}
@@ -0,0 +1,357 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// MaxPage is the last page the API serves for any paged endpoint
+const MaxPage = 500
+
+// ErrNilPage is returned by a Pager whose fetch returned neither a page nor
+// an error
+var ErrNilPage = errors.New("fetch returned a nil page")
+
+// PagedResults is implemented by every paged response, T being the type of
+// its results
+type PagedResults[T any] interface {
+	PageInfo() (page, totalPages int)
+	PageResults() []T
+}
+
+// Pager walks the results of a paged endpoint lazily, fetching the next page
+// only once the current one is exhausted:
+//
+//	pager := tmdb.NewPager(ctx, func(page int) (*tmdb.MoviePagedResults, error) {
+//		return api.WithContext(ctx).GetMoviePopular(map[string]string{"page": strconv.Itoa(page)})
+//	})
+//	for pager.Next() {
+//		movie := pager.Item()
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[P PagedResults[T], T any] struct {
+	// MaxPages caps the number of pages fetched. Zero means up to MaxPage.
+	MaxPages int
+	// MaxItems caps the number of results returned. Zero means no cap.
+	MaxItems int
+
+	ctx        context.Context
+	fetch      func(page int) (P, error)
+	page       P
+	items      []T
+	item       T
+	index      int
+	pageNumber int
+	totalPages int
+	count      int
+	done       bool
+	err        error
+}
+
+// NewPager returns a Pager calling fetch for each page, starting from page 1.
+// The pager stops early once ctx is done.
+func NewPager[P PagedResults[T], T any](ctx context.Context, fetch func(page int) (P, error)) *Pager[P, T] {
+	return &Pager[P, T]{ctx: ctx, fetch: fetch}
+}
+
+// Next advances to the next result, reporting whether there is one
+func (p *Pager[P, T]) Next() bool {
+	if p.done || p.err != nil {
+		return false
+	}
+	if err := p.ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+	if p.MaxItems > 0 && p.count >= p.MaxItems {
+		p.done = true
+		return false
+	}
+
+	for p.index >= len(p.items) {
+		if !p.hasNextPage() {
+			p.done = true
+			return false
+		}
+		page, err := p.fetch(p.pageNumber + 1)
+		if err != nil {
+			p.err = err
+			return false
+		}
+		if isNil(page) {
+			p.err = ErrNilPage
+			return false
+		}
+		p.pageNumber++
+		p.page = page
+		_, p.totalPages = page.PageInfo()
+		p.items = page.PageResults()
+		p.index = 0
+		if len(p.items) == 0 {
+			p.done = true
+			return false
+		}
+	}
+
+	p.item = p.items[p.index]
+	p.index++
+	p.count++
+	return true
+}
+
+// isNil reports whether v is nil, including a nil pointer held in an interface
+func isNil(v any) bool {
+	if v == nil {
+		return true
+	}
+	value := reflect.ValueOf(v)
+	switch value.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Pointer, reflect.Slice:
+		return value.IsNil()
+	}
+	return false
+}
+
+func (p *Pager[P, T]) hasNextPage() bool {
+	if p.pageNumber >= MaxPage || (p.MaxPages > 0 && p.pageNumber >= p.MaxPages) {
+		return false
+	}
+	return p.pageNumber == 0 || p.pageNumber < p.totalPages
+}
+
+// Item returns the current result
+func (p *Pager[P, T]) Item() T {
+	return p.item
+}
+
+// Page returns the response the current result belongs to
+func (p *Pager[P, T]) Page() P {
+	return p.page
+}
+
+// Err returns the error that stopped the pager, if any
+func (p *Pager[P, T]) Err() error {
+	return p.err
+}
+
+// All drains the pager, returning every remaining result
+func (p *Pager[P, T]) All() ([]T, error) {
+	var items []T
+	for p.Next() {
+		items = append(items, p.Item())
+	}
+	return items, p.Err()
+}
+
+// PageInfo implements PagedResults
+func (r *MoviePagedResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *MoviePagedResults) PageResults() []MovieShort {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *MovieDatedResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *MovieDatedResults) PageResults() []MovieShort {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *CompanyMoviePagedResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *CompanyMoviePagedResults) PageResults() []MovieShort {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *MovieSearchResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *MovieSearchResults) PageResults() []MovieShort {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *MovieLists) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *MovieLists) PageResults() []MovieList {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *MovieReviews) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *MovieReviews) PageResults() []MovieReview {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *MovieRecommendations) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *MovieRecommendations) PageResults() []MovieRecommendation {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *TvPagedResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *TvPagedResults) PageResults() []TvShort {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *TvRecommendations) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *TvRecommendations) PageResults() []TvRecommendation {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *PersonPopular) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *PersonPopular) PageResults() []PersonShort {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *PersonTaggedImages) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *PersonTaggedImages) PageResults() []PersonTaggedImage {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *CollectionSearchResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *CollectionSearchResults) PageResults() []CollectionSearchResult {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *CompanySearchResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *CompanySearchResults) PageResults() []CompanySearchResult {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *KeywordSearchResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *KeywordSearchResults) PageResults() []KeywordSearchResult {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *ListSearchResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *ListSearchResults) PageResults() []ListSearchResult {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *PersonSearchResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *PersonSearchResults) PageResults() []PersonSearchResult {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *TvSearchResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *TvSearchResults) PageResults() []TvSearchResult {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *MultiSearchResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *MultiSearchResults) PageResults() []MultiSearchBase {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *RatedTvResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *RatedTvResults) PageResults() []RatedTv {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *RatedTvEpisodeResults) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *RatedTvEpisodeResults) PageResults() []RatedTvEpisode {
+	return r.Results
+}
+
+// PageInfo implements PagedResults
+func (r *ListV4) PageInfo() (page, totalPages int) {
+	return r.Page, r.TotalPages
+}
+
+// PageResults implements PagedResults
+func (r *ListV4) PageResults() []MultiSearchBase {
+	return r.Results
+}
@@ -0,0 +1,129 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	. "gopkg.in/check.v1"
+)
+
+// newPopularServer serves /movie/popular as totalPages pages of two movies
+func (s *LocalSuite) newPopularServer(c *C, totalPages int) (*TMDb, func()) {
+	api, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		fmt.Fprintf(w, `{"page": %d, "total_pages": %d, "total_results": %d, "results": [{"id": %d}, {"id": %d}]}`,
+			page, totalPages, 2*totalPages, 2*page-1, 2*page)
+	})
+	return api, server.Close
+}
+
+func popularPage(ctx context.Context, api *TMDb) func(page int) (*MoviePagedResults, error) {
+	return func(page int) (*MoviePagedResults, error) {
+		return api.WithContext(ctx).GetMoviePopular(map[string]string{"page": strconv.Itoa(page)})
+	}
+}
+
+func movieIDs(movies []MovieShort) []int {
+	ids := make([]int, len(movies))
+	for i, movie := range movies {
+		ids[i] = movie.ID
+	}
+	return ids
+}
+
+func (s *LocalSuite) TestPagerWalksAllPages(c *C) {
+	api, closeServer := s.newPopularServer(c, 3)
+	defer closeServer()
+
+	ctx := context.Background()
+	pager := NewPager(ctx, popularPage(ctx, api))
+	movies, err := pager.All()
+	c.Assert(err, IsNil)
+	c.Assert(movieIDs(movies), DeepEquals, []int{1, 2, 3, 4, 5, 6})
+	c.Assert(pager.Page().Page, Equals, 3)
+}
+
+func (s *LocalSuite) TestPagerCaps(c *C) {
+	api, closeServer := s.newPopularServer(c, 3)
+	defer closeServer()
+	ctx := context.Background()
+
+	pager := NewPager(ctx, popularPage(ctx, api))
+	pager.MaxPages = 2
+	movies, err := pager.All()
+	c.Assert(err, IsNil)
+	c.Assert(movieIDs(movies), DeepEquals, []int{1, 2, 3, 4})
+
+	pager = NewPager(ctx, popularPage(ctx, api))
+	pager.MaxItems = 3
+	movies, err = pager.All()
+	c.Assert(err, IsNil)
+	c.Assert(movieIDs(movies), DeepEquals, []int{1, 2, 3})
+}
+
+func (s *LocalSuite) TestPagerStopsAtMaxPage(c *C) {
+	fetched := 0
+	pager := NewPager(context.Background(), func(page int) (*MoviePagedResults, error) {
+		fetched++
+		return &MoviePagedResults{Page: page, TotalPages: 1000, Results: []MovieShort{{ID: page}}}, nil
+	})
+	movies, err := pager.All()
+	c.Assert(err, IsNil)
+	c.Assert(movies, HasLen, MaxPage)
+	c.Assert(fetched, Equals, MaxPage)
+}
+
+func (s *LocalSuite) TestPagerStopsOnContextCancel(c *C) {
+	api, closeServer := s.newPopularServer(c, 3)
+	defer closeServer()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pager := NewPager(ctx, popularPage(ctx, api))
+	c.Assert(pager.Next(), Equals, true)
+	cancel()
+	c.Assert(pager.Next(), Equals, false)
+	c.Assert(errors.Is(pager.Err(), context.Canceled), Equals, true)
+}
+
+func (s *LocalSuite) TestPagerReportsFetchErrors(c *C) {
+	api, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "2" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"status_code": 9, "status_message": "Service offline."}`)
+			return
+		}
+		fmt.Fprint(w, `{"page": 1, "total_pages": 2, "results": [{"id": 1}]}`)
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	movies, err := NewPager(ctx, popularPage(ctx, api)).All()
+	c.Assert(movieIDs(movies), DeepEquals, []int{1})
+	c.Assert(errors.Is(err, ErrServiceUnavailable), Equals, true)
+}
+
+func (s *LocalSuite) TestPagerReportsNilPages(c *C) {
+	pager := NewPager(context.Background(), func(page int) (*MoviePagedResults, error) {
+		return nil, nil
+	})
+	c.Assert(pager.Next(), Equals, false)
+	c.Assert(errors.Is(pager.Err(), ErrNilPage), Equals, true)
+}
+
+func (s *LocalSuite) TestPagerMultiSearch(c *C) {
+	pager := NewPager(context.Background(), func(page int) (*MultiSearchResults, error) {
+		return &MultiSearchResults{
+			Page:       page,
+			TotalPages: 1,
+			Results:    MultiSearchResultsInfo{&MultiSearchMovieInfo{ID: 550}, &MultiSearchTvInfo{ID: 1396}},
+		}, nil
+	})
+	results, err := pager.All()
+	c.Assert(err, IsNil)
+	c.Assert(results, HasLen, 2)
+	c.Assert(results[1].(*MultiSearchTvInfo).ID, Equals, 1396)
+}
@@ -163,27 +163,30 @@ type PersonTaggedImages struct {
 	Page         int
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
-	Results      []struct {
-		AspectRatio float32 `json:"aspect_ratio"`
-		FilePath    string  `json:"file_path"`
-		ID          string
-		Width       int
-		Height      int
-		Iso639_1    string  `json:"iso_639_1"`
-		VoteAverage float32 `json:"vote_average"`
-		VoteCount   int     `json:"vote_count"`
-		ImageType   string  `json:"image_type"`
-		MediaType   string  `json:"media_type"`
-		Media       struct {
-			Adult         bool
-			BackdropPath  string `json:"backdrop_path"`
-			ID            int
-			OriginalTitle string `json:"original_title"`
-			PosterPath    string `json:"poster_path"`
-			ReleaseDate   string `json:"release_date"`
-			Title         string
-			Popularity    float32
-		}
+	Results      []PersonTaggedImage
+}
+
+// PersonTaggedImage struct
+type PersonTaggedImage struct {
+	AspectRatio float32 `json:"aspect_ratio"`
+	FilePath    string  `json:"file_path"`
+	ID          string
+	Width       int
+	Height      int
+	Iso639_1    string  `json:"iso_639_1"`
+	VoteAverage float32 `json:"vote_average"`
+	VoteCount   int     `json:"vote_count"`
+	ImageType   string  `json:"image_type"`
+	MediaType   string  `json:"media_type"`
+	Media       struct {
+		Adult         bool
+		BackdropPath  string `json:"backdrop_path"`
+		ID            int
+		OriginalTitle string `json:"original_title"`
+		PosterPath    string `json:"poster_path"`
+		ReleaseDate   string `json:"release_date"`
+		Title         string
+		Popularity    float32
 	}
 }
 
@@ -218,9 +221,12 @@ func (tmdb *TMDb) GetPersonInfo(id int, options map[string]string) (*Person, err
 	var availableOptions = map[string]struct{}{
 		"append_to_response": {}}
 	var personInfo Person
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/person/%v?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &personInfo)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/person/%v", id), query)
+	result, err := tmdb.getTmdb(uri, &personInfo)
 	return result.(*Person), err
 }
 
@@ -231,9 +237,12 @@ func (tmdb *TMDb) GetPersonChanges(id int, options map[string]string) (*PersonCh
 		"start_date": {},
 		"end_date":   {}}
 	var changes PersonChanges
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/person/%v/changes?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &changes)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/person/%v/changes", id), query)
+	result, err := tmdb.getTmdb(uri, &changes)
 	return result.(*PersonChanges), err
 }
 
@@ -244,9 +253,12 @@ func (tmdb *TMDb) GetPersonCombinedCredits(id int, options map[string]string) (*
 		"language":           {},
 		"append_to_response": {}}
 	var credits PersonCombinedCredits
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/person/%v/combined_credits?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &credits)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/person/%v/combined_credits", id), query)
+	result, err := tmdb.getTmdb(uri, &credits)
 	return result.(*PersonCombinedCredits), err
 }
 
@@ -254,8 +266,8 @@ func (tmdb *TMDb) GetPersonCombinedCredits(id int, options map[string]string) (*
 // https://developers.themoviedb.org/3/people/get-person-external-ids
 func (tmdb *TMDb) GetPersonExternalIds(id int) (*TvExternalIds, error) {
 	var ids TvExternalIds
-	uri := fmt.Sprintf("%s/person/%v/external_ids?api_key=%s", baseURL, id, tmdb.apiKey)
-	result, err := getTmdb(uri, &ids)
+	uri := tmdb.buildURL(fmt.Sprintf("/person/%v/external_ids", id), nil)
+	result, err := tmdb.getTmdb(uri, &ids)
 	return result.(*TvExternalIds), err
 }
 
@@ -263,8 +275,8 @@ func (tmdb *TMDb) GetPersonExternalIds(id int) (*TvExternalIds, error) {
 // https://developers.themoviedb.org/3/people/get-person-images
 func (tmdb *TMDb) GetPersonImages(id int) (*PersonImages, error) {
 	var images PersonImages
-	uri := fmt.Sprintf("%s/person/%v/images?api_key=%s", baseURL, id, tmdb.apiKey)
-	result, err := getTmdb(uri, &images)
+	uri := tmdb.buildURL(fmt.Sprintf("/person/%v/images", id), nil)
+	result, err := tmdb.getTmdb(uri, &images)
 	return result.(*PersonImages), err
 }
 
@@ -272,8 +284,8 @@ func (tmdb *TMDb) GetPersonImages(id int) (*PersonImages, error) {
 // https://developers.themoviedb.org/3/people/get-latest-person
 func (tmdb *TMDb) GetPersonLatest() (*PersonLatest, error) {
 	var latest PersonLatest
-	uri := fmt.Sprintf("%s/person/latest?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &latest)
+	uri := tmdb.buildURL("/person/latest", nil)
+	result, err := tmdb.getTmdb(uri, &latest)
 	return result.(*PersonLatest), err
 }
 
@@ -284,9 +296,12 @@ func (tmdb *TMDb) GetPersonMovieCredits(id int, options map[string]string) (*Per
 		"language":           {},
 		"append_to_response": {}}
 	var credits PersonMovieCredits
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/person/%v/movie_credits?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &credits)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/person/%v/movie_credits", id), query)
+	result, err := tmdb.getTmdb(uri, &credits)
 	return result.(*PersonMovieCredits), err
 }
 
@@ -296,9 +311,12 @@ func (tmdb *TMDb) GetPersonPopular(options map[string]string) (*PersonPopular, e
 	var availableOptions = map[string]struct{}{
 		"page": {}}
 	var popular PersonPopular
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/person/popular?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &popular)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/person/popular", query)
+	result, err := tmdb.getTmdb(uri, &popular)
 	return result.(*PersonPopular), err
 }
 
@@ -309,9 +327,12 @@ func (tmdb *TMDb) GetPersonTaggedImages(id int, options map[string]string) (*Per
 		"language": {},
 		"page":     {}}
 	var images PersonTaggedImages
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/person/%v/tagged_images?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &images)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/person/%v/tagged_images", id), query)
+	result, err := tmdb.getTmdb(uri, &images)
 	return result.(*PersonTaggedImages), err
 }
 
@@ -322,8 +343,11 @@ func (tmdb *TMDb) GetPersonTvCredits(id int, options map[string]string) (*Person
 		"language":           {},
 		"append_to_response": {}}
 	var credits PersonTvCredits
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/person/%v/tv_credits?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &credits)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/person/%v/tv_credits", id), query)
+	result, err := tmdb.getTmdb(uri, &credits)
 	return result.(*PersonTvCredits), err
 }
@@ -0,0 +1,55 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *LocalSuite) TestRateLimitIsSharedAcrossGoroutines(c *C) {
+	var hits int32
+	server, _ := newFlakyServer(0, http.StatusOK, nil)
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, RateLimit: 100, RateBurst: 2})
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 12; i++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			_, err := api.WithContext(context.Background()).GetMovieInfo(id, nil)
+			c.Check(err, IsNil)
+			atomic.AddInt32(&hits, 1)
+		}(550 + i)
+	}
+	wg.Wait()
+
+	// 2 requests go out at once, the remaining 10 are spaced 10ms apart
+	c.Assert(time.Since(start) >= 90*time.Millisecond, Equals, true)
+	c.Assert(atomic.LoadInt32(&hits), Equals, int32(12))
+}
+
+func (s *LocalSuite) TestRateLimitRespectsContext(c *C) {
+	limiter := newRateLimiter(1, 1)
+	c.Assert(limiter.wait(context.Background()), IsNil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := limiter.wait(ctx)
+	c.Assert(errors.Is(err, context.DeadlineExceeded), Equals, true)
+	c.Assert(time.Since(start) < 500*time.Millisecond, Equals, true)
+
+	// The abandoned reservation is handed back
+	c.Assert(limiter.tokens > -1, Equals, true)
+}
+
+func (s *LocalSuite) TestRateLimitDisabledByDefault(c *C) {
+	c.Assert(Init(Config{APIKey: "key"}).limiter, IsNil)
+}
@@ -0,0 +1,100 @@
+package tmdb
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// DefaultRetryPolicy is a reasonable policy for batch jobs
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  500 * time.Millisecond,
+	MaxBackoff:  10 * time.Second,
+}
+
+// RetryPolicy struct. Only GET requests are retried, after connection errors,
+// 429 and 5xx responses. A Retry-After header sent by the API takes
+// precedence over the computed backoff.
+type RetryPolicy struct {
+	MaxAttempts int           // Total attempts, including the first one
+	MinBackoff  time.Duration // Wait before the first retry, doubled on each further one
+	MaxBackoff  time.Duration // Upper bound for the computed backoff
+}
+
+// shouldRetry reports whether a failed attempt is worth repeating
+func (tmdb *TMDb) shouldRetry(res *response, err error) bool {
+	if err != nil {
+		return tmdb.Context().Err() == nil
+	}
+	switch res.statusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// backoff returns how long to wait after the given failed attempt
+func (p RetryPolicy) backoff(attempt int, res *response) time.Duration {
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.header.Get("Retry-After"), time.Now()); ok {
+			return wait
+		}
+	}
+
+	wait := p.MinBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || wait < p.MaxBackoff); i++ {
+		wait *= 2
+	}
+	if p.MaxBackoff > 0 && wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+	if wait <= 0 {
+		return 0
+	}
+
+	// Full jitter over the upper half, so concurrent clients spread out
+	half := int64(wait / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+// parseRetryAfter accepts both forms of the header: delay seconds and HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if wait := date.Sub(now); wait > 0 {
+			return wait, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// sleep waits for d, returning early with the context error if ctx is done
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,159 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+// newFlakyServer fails the first failures requests with status, then succeeds
+func newFlakyServer(failures int32, status int, header http.Header) (*httptest.Server, *int32) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) <= failures {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(status)
+			fmt.Fprint(w, `{"status_code": 25, "status_message": "Your request count is over the allowed limit."}`)
+			return
+		}
+		fmt.Fprint(w, `{"id": 550, "title": "Fight Club"}`)
+	}))
+	return server, &hits
+}
+
+var fastRetry = RetryPolicy{MaxAttempts: 4, MinBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+
+func (s *LocalSuite) TestRetrySucceedsAfterTransientFailures(c *C) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusTooManyRequests} {
+		server, hits := newFlakyServer(3, status, nil)
+		api := Init(Config{APIKey: "key", BaseURL: server.URL, Retry: fastRetry})
+
+		movie, err := api.GetMovieInfo(550, nil)
+		c.Check(err, IsNil)
+		c.Check(movie.Title, Equals, "Fight Club")
+		c.Check(atomic.LoadInt32(hits), Equals, int32(4))
+		server.Close()
+	}
+}
+
+func (s *LocalSuite) TestRetryGivesUpAfterMaxAttempts(c *C) {
+	server, hits := newFlakyServer(10, http.StatusTooManyRequests, nil)
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, Retry: fastRetry})
+
+	_, err := api.GetMovieInfo(550, nil)
+	c.Assert(errors.Is(err, ErrRateLimited), Equals, true)
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(4))
+}
+
+func (s *LocalSuite) TestRetrySkipsClientErrors(c *C) {
+	server, hits := newFlakyServer(10, http.StatusNotFound, nil)
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, Retry: fastRetry})
+
+	_, err := api.GetMovieInfo(550, nil)
+	c.Assert(err, NotNil)
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(1))
+}
+
+func (s *LocalSuite) TestRetryDisabledByDefault(c *C) {
+	server, hits := newFlakyServer(1, http.StatusServiceUnavailable, nil)
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL})
+
+	_, err := api.GetMovieInfo(550, nil)
+	c.Assert(err, NotNil)
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(1))
+}
+
+func (s *LocalSuite) TestRetryHonorsRetryAfter(c *C) {
+	server, hits := newFlakyServer(1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"1"}})
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, Retry: fastRetry})
+
+	start := time.Now()
+	_, err := api.GetMovieInfo(550, nil)
+	c.Assert(err, IsNil)
+	c.Assert(time.Since(start) >= time.Second, Equals, true)
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(2))
+}
+
+func (s *LocalSuite) TestRetryStopsWhenContextIsDone(c *C) {
+	server, hits := newFlakyServer(10, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"60"}})
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, Retry: fastRetry})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := api.WithContext(ctx).GetMovieInfo(550, nil)
+	c.Assert(errors.Is(err, context.DeadlineExceeded), Equals, true)
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(1))
+}
+
+func (s *LocalSuite) TestRetryBackoff(c *C) {
+	policy := RetryPolicy{MinBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, max := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		max *= time.Millisecond
+		wait := policy.backoff(attempt+1, nil)
+		c.Check(wait >= max/2 && wait <= max, Equals, true, Commentf("attempt %d waited %s", attempt+1, wait))
+	}
+}
+
+func (s *LocalSuite) TestParseRetryAfter(c *C) {
+	now := time.Date(2015, time.October, 21, 7, 28, 0, 0, time.UTC)
+	tests := []struct {
+		value string
+		wait  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"120", 2 * time.Minute, true},
+		{"-1", 0, false},
+		{"Wed, 21 Oct 2015 07:28:30 GMT", 30 * time.Second, true},
+		{"Wed, 21 Oct 2015 07:27:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+	for _, test := range tests {
+		wait, ok := parseRetryAfter(test.value, now)
+		c.Check(wait, Equals, test.wait, Commentf("%q", test.value))
+		c.Check(ok, Equals, test.ok, Commentf("%q", test.value))
+	}
+}
+
+func (s *LocalSuite) TestRetryAfterConnectionReset(c *C) {
+	var hits int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			conn, _, err := w.(http.Hijacker).Hijack()
+			c.Check(err, IsNil)
+			conn.Close()
+			return
+		}
+		fmt.Fprint(w, `{"id": 550}`)
+	}))
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, Retry: fastRetry})
+
+	movie, err := api.GetMovieInfo(550, nil)
+	c.Assert(err, IsNil)
+	c.Assert(movie.ID, Equals, 550)
+	c.Assert(atomic.LoadInt32(&hits), Equals, int32(2))
+}
+
+func (s *LocalSuite) TestRetrySkipsWrites(c *C) {
+	server, hits := newFlakyServer(10, http.StatusServiceUnavailable, nil)
+	defer server.Close()
+	api := Init(Config{APIKey: "key", BaseURL: server.URL, Retry: fastRetry})
+
+	_, err := api.RateMovie(550, "session", 8)
+	c.Assert(errors.Is(err, ErrServiceUnavailable), Equals, true)
+	c.Assert(atomic.LoadInt32(hits), Equals, int32(1))
+}
@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // Review struct
@@ -20,7 +21,7 @@ type Review struct {
 // https://developers.themoviedb.org/3/reviews/get-review-details
 func (tmdb *TMDb) GetReviewInfo(id string) (*Review, error) {
 	var reviewInfo Review
-	uri := fmt.Sprintf("%s/review/%v?api_key=%s", baseURL, id, tmdb.apiKey)
-	result, err := getTmdb(uri, &reviewInfo)
+	uri := tmdb.buildURL(fmt.Sprintf("/review/%v", url.PathEscape(id)), nil)
+	result, err := tmdb.getTmdb(uri, &reviewInfo)
 	return result.(*Review), err
 }
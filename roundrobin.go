@@ -27,7 +27,7 @@ func (r *RoundRobin) GetTicker() int {
 
 	ticker := r.currentTicker
 
-	if r.currentTicker < r.maxAllowed {
+	if r.currentTicker < r.maxAllowed-1 {
 		r.currentTicker = r.currentTicker + 1
 	} else {
 		r.currentTicker = 0
@@ -41,7 +41,7 @@ func (r *RoundRobin) Next() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if r.currentTicker < r.maxAllowed {
+	if r.currentTicker < r.maxAllowed-1 {
 		r.currentTicker = r.currentTicker + 1
 	} else {
 		r.currentTicker = 0
@@ -3,8 +3,6 @@ package tmdb
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
-	"net/url"
 )
 
 // ErrUnknownMediaType var
@@ -12,57 +10,69 @@ var ErrUnknownMediaType = errors.New("Unknown media type. Unable to unmarhal to
 
 // CollectionSearchResults struct
 type CollectionSearchResults struct {
-	Page    int
-	Results []struct {
-		ID           int
-		BackdropPath string `json:"backdrop_path"`
-		Name         string
-		PosterPath   string `json:"poster_path"`
-	}
+	Page         int
+	Results      []CollectionSearchResult
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
 }
 
+// CollectionSearchResult struct
+type CollectionSearchResult struct {
+	ID           int
+	BackdropPath string `json:"backdrop_path"`
+	Name         string
+	PosterPath   string `json:"poster_path"`
+}
+
 // CompanySearchResults struct
 type CompanySearchResults struct {
-	Page    int
-	Results []struct {
-		ID       int
-		LogoPath string `json:"logo_path"`
-		Name     string
-	}
+	Page         int
+	Results      []CompanySearchResult
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
 }
 
+// CompanySearchResult struct
+type CompanySearchResult struct {
+	ID       int
+	LogoPath string `json:"logo_path"`
+	Name     string
+}
+
 // KeywordSearchResults struct
 type KeywordSearchResults struct {
-	Page    int
-	Results []struct {
-		ID   int
-		Name string
-	}
+	Page         int
+	Results      []KeywordSearchResult
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
 }
 
+// KeywordSearchResult struct
+type KeywordSearchResult struct {
+	ID   int
+	Name string
+}
+
 // ListSearchResults struct
 type ListSearchResults struct {
-	Page    int
-	Results []struct {
-		Description   string
-		FavoriteCount int `json:"favorite_count"`
-		ID            string
-		ItemCount     int    `json:"item_count"`
-		Iso639_1      string `json:"iso_639_1"`
-		ListType      string `json:"list_type"`
-		Name          string
-		PosterPath    string `json:"poster_path"`
-	}
+	Page         int
+	Results      []ListSearchResult
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
 }
 
+// ListSearchResult struct
+type ListSearchResult struct {
+	Description   string
+	FavoriteCount int `json:"favorite_count"`
+	ID            string
+	ItemCount     int    `json:"item_count"`
+	Iso639_1      string `json:"iso_639_1"`
+	ListType      string `json:"list_type"`
+	Name          string
+	PosterPath    string `json:"poster_path"`
+}
+
 // MovieSearchResults struct
 type MovieSearchResults struct {
 	Page         int
@@ -252,50 +262,56 @@ func (res MultiSearchResults) GetPersonResults() (personResults []MultiSearchPer
 
 // PersonSearchResults struct
 type PersonSearchResults struct {
-	Page    int
-	Results []struct {
-		Adult       bool
-		ID          int
-		Name        string
-		Popularity  float32
-		ProfilePath string `json:"profile_path"`
-		KnownFor    []struct {
-			Adult         bool
-			BackdropPath  string `json:"backdrop_path"`
-			ID            int
-			OriginalTitle string `json:"original_title"`
-			ReleaseDate   string `json:"release_date"`
-			PosterPath    string `json:"poster_path"`
-			Popularity    float32
-			Title         string
-			VoteAverage   float32 `json:"vote_average"`
-			VoteCount     uint32  `json:"vote_count"`
-			MediaType     string  `json:"media_type"`
-		} `json:"known_for"`
-	}
+	Page         int
+	Results      []PersonSearchResult
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
 }
 
-// TvSearchResults struct
-type TvSearchResults struct {
-	Page    int
-	Results []struct {
+// PersonSearchResult struct
+type PersonSearchResult struct {
+	Adult       bool
+	ID          int
+	Name        string
+	Popularity  float32
+	ProfilePath string `json:"profile_path"`
+	KnownFor    []struct {
+		Adult         bool
 		BackdropPath  string `json:"backdrop_path"`
 		ID            int
-		OriginalName  string   `json:"original_name"`
-		FirstAirDate  string   `json:"first_air_date"`
-		OriginCountry []string `json:"origin_country"`
-		PosterPath    string   `json:"poster_path"`
+		OriginalTitle string `json:"original_title"`
+		ReleaseDate   string `json:"release_date"`
+		PosterPath    string `json:"poster_path"`
 		Popularity    float32
-		Name          string
+		Title         string
 		VoteAverage   float32 `json:"vote_average"`
 		VoteCount     uint32  `json:"vote_count"`
-	}
+		MediaType     string  `json:"media_type"`
+	} `json:"known_for"`
+}
+
+// TvSearchResults struct
+type TvSearchResults struct {
+	Page         int
+	Results      []TvSearchResult
 	TotalPages   int `json:"total_pages"`
 	TotalResults int `json:"total_results"`
 }
 
+// TvSearchResult struct
+type TvSearchResult struct {
+	BackdropPath  string `json:"backdrop_path"`
+	ID            int
+	OriginalName  string   `json:"original_name"`
+	FirstAirDate  string   `json:"first_air_date"`
+	OriginCountry []string `json:"origin_country"`
+	PosterPath    string   `json:"poster_path"`
+	Popularity    float32
+	Name          string
+	VoteAverage   float32 `json:"vote_average"`
+	VoteCount     uint32  `json:"vote_count"`
+}
+
 // SearchCollection searches for collections by name
 // https://developers.themoviedb.org/3/search/search-collections
 func (tmdb *TMDb) SearchCollection(name string, options map[string]string) (*CollectionSearchResults, error) {
@@ -303,10 +319,13 @@ func (tmdb *TMDb) SearchCollection(name string, options map[string]string) (*Col
 		"page":     {},
 		"language": {}}
 	var collections CollectionSearchResults
-	safeName := url.QueryEscape(name)
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/search/collection?query=%s&api_key=%s%s", baseURL, safeName, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &collections)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("query", name)
+	uri := tmdb.buildURL("/search/collection", query)
+	result, err := tmdb.getTmdb(uri, &collections)
 	return result.(*CollectionSearchResults), err
 }
 
@@ -316,10 +335,13 @@ func (tmdb *TMDb) SearchCompany(name string, options map[string]string) (*Compan
 	var availableOptions = map[string]struct{}{
 		"page": {}}
 	var companies CompanySearchResults
-	safeName := url.QueryEscape(name)
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/search/company?query=%s&api_key=%s%s", baseURL, safeName, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &companies)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("query", name)
+	uri := tmdb.buildURL("/search/company", query)
+	result, err := tmdb.getTmdb(uri, &companies)
 	return result.(*CompanySearchResults), err
 }
 
@@ -329,10 +351,13 @@ func (tmdb *TMDb) SearchKeyword(name string, options map[string]string) (*Keywor
 	var availableOptions = map[string]struct{}{
 		"page": {}}
 	var keywords KeywordSearchResults
-	safeName := url.QueryEscape(name)
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/search/keyword?query=%s&api_key=%s%s", baseURL, safeName, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &keywords)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("query", name)
+	uri := tmdb.buildURL("/search/keyword", query)
+	result, err := tmdb.getTmdb(uri, &keywords)
 	return result.(*KeywordSearchResults), err
 }
 
@@ -343,10 +368,13 @@ func (tmdb *TMDb) SearchList(name string, options map[string]string) (*ListSearc
 		"page":          {},
 		"include_adult": {}}
 	var lists ListSearchResults
-	safeName := url.QueryEscape(name)
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/search/list?query=%s&api_key=%s%s", baseURL, safeName, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &lists)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("query", name)
+	uri := tmdb.buildURL("/search/list", query)
+	result, err := tmdb.getTmdb(uri, &lists)
 	return result.(*ListSearchResults), err
 }
 
@@ -361,10 +389,13 @@ func (tmdb *TMDb) SearchMovie(name string, options map[string]string) (*MovieSea
 		"primary_release_year": {},
 		"search_type":          {}}
 	var movies MovieSearchResults
-	safeName := url.QueryEscape(name)
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/search/movie?query=%s&api_key=%s%s", baseURL, safeName, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &movies)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("query", name)
+	uri := tmdb.buildURL("/search/movie", query)
+	result, err := tmdb.getTmdb(uri, &movies)
 	return result.(*MovieSearchResults), err
 }
 
@@ -376,10 +407,13 @@ func (tmdb *TMDb) SearchMulti(name string, options map[string]string) (*MultiSea
 		"language":      {},
 		"include_adult": {}}
 	var multis MultiSearchResults
-	safeName := url.QueryEscape(name)
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/search/multi?query=%s&api_key=%s%s", baseURL, safeName, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &multis)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("query", name)
+	uri := tmdb.buildURL("/search/multi", query)
+	result, err := tmdb.getTmdb(uri, &multis)
 	return result.(*MultiSearchResults), err
 }
 
@@ -391,10 +425,13 @@ func (tmdb *TMDb) SearchPerson(name string, options map[string]string) (*PersonS
 		"search_type":   {},
 		"include_adult": {}}
 	var people PersonSearchResults
-	safeName := url.QueryEscape(name)
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/search/person?query=%s&api_key=%s%s", baseURL, safeName, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &people)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("query", name)
+	uri := tmdb.buildURL("/search/person", query)
+	result, err := tmdb.getTmdb(uri, &people)
 	return result.(*PersonSearchResults), err
 }
 
@@ -407,9 +444,12 @@ func (tmdb *TMDb) SearchTv(name string, options map[string]string) (*TvSearchRes
 		"search_type":         {},
 		"first_air_date_year": {}}
 	var shows TvSearchResults
-	safeName := url.QueryEscape(name)
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/search/tv?query=%s&api_key=%s%s", baseURL, safeName, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &shows)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	query.Set("query", name)
+	uri := tmdb.buildURL("/search/tv", query)
+	result, err := tmdb.getTmdb(uri, &shows)
 	return result.(*TvSearchResults), err
 }
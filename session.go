@@ -0,0 +1,169 @@
+package tmdb
+
+import (
+	"context"
+)
+
+// Session remembers the session id and account id of a signed in user, so
+// the account methods can be called without passing them every time
+type Session struct {
+	ID        string
+	AccountID int
+	tmdb      *TMDb
+}
+
+// NewSession returns a Session for sessionID, looking up its account id
+func (tmdb *TMDb) NewSession(sessionID string) (*Session, error) {
+	account, err := tmdb.GetAccountInfo(sessionID)
+	if err != nil {
+		return nil, err
+	}
+	return &Session{ID: sessionID, AccountID: account.ID, tmdb: tmdb}, nil
+}
+
+// NewSessionFromAccessToken converts a v4 user access token to a v3 session
+// and returns a Session for it
+func (tmdb *TMDb) NewSessionFromAccessToken(accessToken string) (*Session, error) {
+	session, err := tmdb.ConvertAuthAccessToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	return tmdb.NewSession(session.SessionID)
+}
+
+// WithContext returns a copy of the session whose requests are bound to ctx
+func (session *Session) WithContext(ctx context.Context) *Session {
+	clone := *session
+	clone.tmdb = session.tmdb.WithContext(ctx)
+	return &clone
+}
+
+// Delete logs the user out by invalidating the session
+func (session *Session) Delete() (*Status, error) {
+	return session.tmdb.DeleteSession(session.ID)
+}
+
+// AccountInfo gets the basic information for the account
+func (session *Session) AccountInfo() (*AccountInfo, error) {
+	return session.tmdb.GetAccountInfo(session.ID)
+}
+
+// Lists gets the lists that the account has created and marked as a favorite
+func (session *Session) Lists(options map[string]string) (*MovieLists, error) {
+	return session.tmdb.GetAccountLists(session.AccountID, session.ID, options)
+}
+
+// FavoriteMovies gets the favorite movies of the account
+func (session *Session) FavoriteMovies(options map[string]string) (*MoviePagedResults, error) {
+	return session.tmdb.GetAccountFavoriteMovies(session.AccountID, session.ID, options)
+}
+
+// FavoriteTv gets the favorite TV shows of the account
+func (session *Session) FavoriteTv(options map[string]string) (*TvPagedResults, error) {
+	return session.tmdb.GetAccountFavoriteTv(session.AccountID, session.ID, options)
+}
+
+// RatedMovies gets the movies rated by the account
+func (session *Session) RatedMovies(options map[string]string) (*MoviePagedResults, error) {
+	return session.tmdb.GetAccountRatedMovies(session.AccountID, session.ID, options)
+}
+
+// RatedTv gets the TV shows rated by the account
+func (session *Session) RatedTv(options map[string]string) (*TvPagedResults, error) {
+	return session.tmdb.GetAccountRatedTv(session.AccountID, session.ID, options)
+}
+
+// WatchlistMovies gets the movies on the watchlist of the account
+func (session *Session) WatchlistMovies(options map[string]string) (*MoviePagedResults, error) {
+	return session.tmdb.GetAccountWatchlistMovies(session.AccountID, session.ID, options)
+}
+
+// WatchlistTv gets the TV shows on the watchlist of the account
+func (session *Session) WatchlistTv(options map[string]string) (*TvPagedResults, error) {
+	return session.tmdb.GetAccountWatchlistTv(session.AccountID, session.ID, options)
+}
+
+// MarkFavorite adds a movie or TV show to the favorites of the account
+func (session *Session) MarkFavorite(mediaType MediaType, mediaID int) (*Status, error) {
+	return session.tmdb.MarkFavorite(session.AccountID, session.ID, mediaType, mediaID)
+}
+
+// UnmarkFavorite removes a movie or TV show from the favorites of the account
+func (session *Session) UnmarkFavorite(mediaType MediaType, mediaID int) (*Status, error) {
+	return session.tmdb.UnmarkFavorite(session.AccountID, session.ID, mediaType, mediaID)
+}
+
+// AddToWatchlist adds a movie or TV show to the watchlist of the account
+func (session *Session) AddToWatchlist(mediaType MediaType, mediaID int) (*Status, error) {
+	return session.tmdb.AddToWatchlist(session.AccountID, session.ID, mediaType, mediaID)
+}
+
+// RemoveFromWatchlist removes a movie or TV show from the watchlist of the account
+func (session *Session) RemoveFromWatchlist(mediaType MediaType, mediaID int) (*Status, error) {
+	return session.tmdb.RemoveFromWatchlist(session.AccountID, session.ID, mediaType, mediaID)
+}
+
+// MovieAccountStates gets whether a movie has been rated, favorited or added to the watchlist
+func (session *Session) MovieAccountStates(id int) (*MovieAccountState, error) {
+	return session.tmdb.GetMovieAccountStates(id, session.ID)
+}
+
+// TvAccountStates gets whether a TV show has been rated, favorited or added to the watchlist
+func (session *Session) TvAccountStates(id int) (*TvAccountState, error) {
+	return session.tmdb.GetTvAccountStates(id, session.ID)
+}
+
+// RateMovie rates a movie, from 0.5 to 10 in steps of 0.5
+func (session *Session) RateMovie(id int, value float32) (*Status, error) {
+	return session.tmdb.RateMovie(id, session.ID, value)
+}
+
+// DeleteMovieRating removes the rating of a movie
+func (session *Session) DeleteMovieRating(id int) (*Status, error) {
+	return session.tmdb.DeleteMovieRating(id, session.ID)
+}
+
+// RateTv rates a TV show, from 0.5 to 10 in steps of 0.5
+func (session *Session) RateTv(id int, value float32) (*Status, error) {
+	return session.tmdb.RateTv(id, session.ID, value)
+}
+
+// DeleteTvRating removes the rating of a TV show
+func (session *Session) DeleteTvRating(id int) (*Status, error) {
+	return session.tmdb.DeleteTvRating(id, session.ID)
+}
+
+// RateTvEpisode rates a TV episode, from 0.5 to 10 in steps of 0.5
+func (session *Session) RateTvEpisode(showID, seasonNum, episodeNum int, value float32) (*Status, error) {
+	return session.tmdb.RateTvEpisode(showID, seasonNum, episodeNum, session.ID, value)
+}
+
+// DeleteTvEpisodeRating removes the rating of a TV episode
+func (session *Session) DeleteTvEpisodeRating(showID, seasonNum, episodeNum int) (*Status, error) {
+	return session.tmdb.DeleteTvEpisodeRating(showID, seasonNum, episodeNum, session.ID)
+}
+
+// CreateList creates a list owned by the account
+func (session *Session) CreateList(name, description, language string) (*ListCreateStatus, error) {
+	return session.tmdb.CreateList(session.ID, name, description, language)
+}
+
+// DeleteList deletes a list owned by the account
+func (session *Session) DeleteList(id string) (*Status, error) {
+	return session.tmdb.DeleteList(id, session.ID)
+}
+
+// AddListItem adds a movie to a list owned by the account
+func (session *Session) AddListItem(id string, movieID int) (*Status, error) {
+	return session.tmdb.PostListAddItem(id, session.ID, movieID)
+}
+
+// RemoveListItem removes a movie from a list owned by the account
+func (session *Session) RemoveListItem(id string, movieID int) (*Status, error) {
+	return session.tmdb.PostListRemoveItem(id, session.ID, movieID)
+}
+
+// ClearList removes every movie from a list owned by the account
+func (session *Session) ClearList(id string) (*Status, error) {
+	return session.tmdb.PostListClear(id, session.ID)
+}
@@ -0,0 +1,93 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *LocalSuite) TestSession(c *C) {
+	var deleted string
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "POST /authentication/session/convert/4":
+			w.Write([]byte(`{"success":true,"session_id":"session"}`))
+		case "GET /account":
+			c.Check(r.URL.Query().Get("session_id"), Equals, "session")
+			w.Write([]byte(`{"id":7,"username":"tyler"}`))
+		case "GET /account/7/watchlist/movies":
+			c.Check(r.URL.Query().Get("session_id"), Equals, "session")
+			w.Write([]byte(`{"page":1,"results":[{"id":550}],"total_pages":1,"total_results":1}`))
+		case "POST /movie/550/rating":
+			c.Check(r.URL.Query().Get("session_id"), Equals, "session")
+			w.Write([]byte(`{"success":true,"status_code":1}`))
+		case "DELETE /authentication/session":
+			var body struct {
+				SessionID string `json:"session_id"`
+			}
+			c.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+			deleted = body.SessionID
+			w.Write([]byte(`{"success":true}`))
+		default:
+			c.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	session, err := tmdb.NewSessionFromAccessToken("user-token")
+	c.Assert(err, IsNil)
+	c.Assert(session.ID, Equals, "session")
+	c.Assert(session.AccountID, Equals, 7)
+
+	watchlist, err := session.WatchlistMovies(nil)
+	c.Assert(err, IsNil)
+	c.Assert(movieIDs(watchlist.Results), DeepEquals, []int{550})
+	_, err = session.RateMovie(550, 9)
+	c.Assert(err, IsNil)
+
+	status, err := session.Delete()
+	c.Assert(err, IsNil)
+	c.Assert(status.Success, Equals, true)
+	c.Assert(deleted, Equals, "session")
+}
+
+func (s *LocalSuite) TestAuthenticationExpiry(c *C) {
+	var token AuthenticationToken
+	err := json.Unmarshal([]byte(`{"success": true, "expires_at": "2016-08-26 17:04:39 UTC"}`), &token)
+	c.Assert(err, IsNil)
+	c.Assert(token.Expires.Equal(time.Date(2016, time.August, 26, 17, 4, 39, 0, time.UTC)), Equals, true)
+	c.Assert(token.Expired(), Equals, true)
+
+	var guest AuthenticationGuestSession
+	expiresAt := time.Now().Add(time.Hour).UTC().Format(expiryFormat)
+	err = json.Unmarshal([]byte(`{"success": true, "expires_at": "`+expiresAt+`"}`), &guest)
+	c.Assert(err, IsNil)
+	c.Assert(guest.Expired(), Equals, false)
+
+	c.Assert((&AuthenticationToken{}).Expired(), Equals, true)
+}
+
+func (s *LocalSuite) TestAuthenticationMalformedExpiry(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/authentication/token/new":
+			w.Write([]byte(`{"success": true, "request_token": "token", "expires_at": "2016-08-26T17:04:39Z"}`))
+		case "/authentication/guest_session/new":
+			w.Write([]byte(`{"success": true, "guest_session_id": "guest", "expires_at": "tomorrow"}`))
+		}
+	})
+	defer server.Close()
+
+	token, err := tmdb.GetAuthToken()
+	c.Assert(err, IsNil)
+	c.Assert(token.RequestToken, Equals, "token")
+	c.Assert(token.Expires.IsZero(), Equals, true)
+	c.Assert(token.Expired(), Equals, true)
+
+	guest, err := tmdb.GetAuthGuestSession()
+	c.Assert(err, IsNil)
+	c.Assert(guest.GuestSessionID, Equals, "guest")
+	c.Assert(guest.Expires.IsZero(), Equals, true)
+}
@@ -1,9 +1,5 @@
 package tmdb
 
-import (
-	"fmt"
-)
-
 // Timezones map
 type Timezones []map[string][]string
 
@@ -11,7 +7,7 @@ type Timezones []map[string][]string
 // https://developers.themoviedb.org/3/configuration/get-timezones
 func (tmdb *TMDb) GetTimezonesList() (*Timezones, error) {
 	var timezoneList Timezones
-	uri := fmt.Sprintf("%s/timezones/list?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &timezoneList)
+	uri := tmdb.buildURL("/timezones/list", nil)
+	result, err := tmdb.getTmdb(uri, &timezoneList)
 	return result.(*Timezones), err
 }
@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
 )
 
 
@@ -9,7 +10,7 @@ import (
 // https://developers.themoviedb.org/3/trending/get-trending
 func (tmdb *TMDb) GetTrending(media_type,time_window string) (*MoviePagedResults, error) {
 	var nowPlaying MoviePagedResults
-	uri := fmt.Sprintf("%s/trending/%v/%v?api_key=%s", baseURL, media_type, time_window, tmdb.apiKey)
-	result, err := getTmdb(uri, &nowPlaying)
+	uri := tmdb.buildURL(fmt.Sprintf("/trending/%v/%v", url.PathEscape(media_type), url.PathEscape(time_window)), nil)
+	result, err := tmdb.getTmdb(uri, &nowPlaying)
 	return result.(*MoviePagedResults), err
 }
@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // TV struct
@@ -80,6 +81,10 @@ type TV struct {
 	Translations      *TvTranslations      `json:",omitempty"`
 	Videos            *TvVideos            `json:",omitempty"`
 	ExternalIDs       *TvExternalIds       `json:"external_ids,omitempty"`
+	WatchProviders    *WatchProviders      `json:"watch/providers,omitempty"`
+	ContentRatings    *TvContentRatings    `json:"content_ratings,omitempty"`
+	EpisodeGroups     *TvEpisodeGroups     `json:"episode_groups,omitempty"`
+	AggregateCredits  *TvAggregateCredits  `json:"aggregate_credits,omitempty"`
 }
 
 // TvShort struct
@@ -146,6 +151,30 @@ type TvChanges struct {
 	}
 }
 
+// TvContentRatings struct
+type TvContentRatings struct {
+	ID      int `json:",omitempty"`
+	Results []TvContentRating
+}
+
+// TvContentRating struct
+type TvContentRating struct {
+	Descriptors []string
+	Iso3166_1   string `json:"iso_3166_1"`
+	Rating      string
+}
+
+// Country returns the content rating of the TV show in an ISO 3166-1
+// country, and whether it has one
+func (ratings *TvContentRatings) Country(country string) (TvContentRating, bool) {
+	for _, rating := range ratings.Results {
+		if rating.Iso3166_1 == country {
+			return rating, true
+		}
+	}
+	return TvContentRating{}, false
+}
+
 // TvCredits struct
 type TvCredits struct {
 	ID   int
@@ -176,6 +205,147 @@ type TvCredits struct {
 	Videos       *TvVideos       `json:",omitempty"`
 }
 
+// TvAggregateCredits struct holds the cast and crew of every episode of a TV
+// show or season, with one entry per person listing all of their roles or jobs
+type TvAggregateCredits struct {
+	ID   int `json:",omitempty"`
+	Cast []TvAggregateCast
+	Crew []TvAggregateCrew
+}
+
+// TvAggregateCast struct
+type TvAggregateCast struct {
+	Adult              bool
+	Gender             int
+	ID                 int
+	KnownForDepartment string `json:"known_for_department"`
+	Name               string
+	OriginalName       string `json:"original_name"`
+	Popularity         float32
+	ProfilePath        string `json:"profile_path"`
+	Roles              []TvAggregateRole
+	TotalEpisodeCount  int `json:"total_episode_count"`
+	Order              int
+}
+
+// TvAggregateRole struct
+type TvAggregateRole struct {
+	CreditID     string `json:"credit_id"`
+	Character    string
+	EpisodeCount int `json:"episode_count"`
+}
+
+// TvAggregateCrew struct
+type TvAggregateCrew struct {
+	Adult              bool
+	Gender             int
+	ID                 int
+	KnownForDepartment string `json:"known_for_department"`
+	Name               string
+	OriginalName       string `json:"original_name"`
+	Popularity         float32
+	ProfilePath        string `json:"profile_path"`
+	Jobs               []TvAggregateJob
+	Department         string
+	TotalEpisodeCount  int `json:"total_episode_count"`
+}
+
+// TvAggregateJob struct
+type TvAggregateJob struct {
+	CreditID     string `json:"credit_id"`
+	Job          string
+	EpisodeCount int `json:"episode_count"`
+}
+
+// TvEpisodeGroupType type
+type TvEpisodeGroupType int
+
+// Ways episode groups order the episodes of a TV show
+const (
+	EpisodeGroupOriginalAirDate TvEpisodeGroupType = 1
+	EpisodeGroupAbsolute        TvEpisodeGroupType = 2
+	EpisodeGroupDVD             TvEpisodeGroupType = 3
+	EpisodeGroupDigital         TvEpisodeGroupType = 4
+	EpisodeGroupStoryArc        TvEpisodeGroupType = 5
+	EpisodeGroupProduction      TvEpisodeGroupType = 6
+	EpisodeGroupTV              TvEpisodeGroupType = 7
+)
+
+var episodeGroupTypeNames = map[TvEpisodeGroupType]string{
+	EpisodeGroupOriginalAirDate: "Original air date",
+	EpisodeGroupAbsolute:        "Absolute",
+	EpisodeGroupDVD:             "DVD",
+	EpisodeGroupDigital:         "Digital",
+	EpisodeGroupStoryArc:        "Story arc",
+	EpisodeGroupProduction:      "Production",
+	EpisodeGroupTV:              "TV",
+}
+
+// String returns the name of the episode group type
+func (t TvEpisodeGroupType) String() string {
+	if name, ok := episodeGroupTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("TvEpisodeGroupType(%d)", int(t))
+}
+
+// TvEpisodeGroups struct
+type TvEpisodeGroups struct {
+	ID      int `json:",omitempty"`
+	Results []TvEpisodeGroupShort
+}
+
+// TvEpisodeGroupShort struct
+type TvEpisodeGroupShort struct {
+	Description  string
+	EpisodeCount int `json:"episode_count"`
+	GroupCount   int `json:"group_count"`
+	ID           string
+	Name         string
+	Network      *Network
+	Type         TvEpisodeGroupType
+}
+
+// TvEpisodeGroup struct. The Order fields of groups and episodes give their
+// position within the group.
+type TvEpisodeGroup struct {
+	Description  string
+	EpisodeCount int `json:"episode_count"`
+	GroupCount   int `json:"group_count"`
+	Groups       []TvEpisodeGroupPart
+	ID           string
+	Name         string
+	Network      *Network
+	Type         TvEpisodeGroupType
+}
+
+// TvEpisodeGroupPart struct is one group, such as a season or an arc, of an
+// episode group
+type TvEpisodeGroupPart struct {
+	ID       string
+	Name     string
+	Order    int
+	Locked   bool
+	Episodes []TvEpisodeGroupEpisode
+}
+
+// TvEpisodeGroupEpisode struct
+type TvEpisodeGroupEpisode struct {
+	AirDate        string `json:"air_date"`
+	EpisodeNumber  int    `json:"episode_number"`
+	ID             int
+	Name           string
+	Order          int
+	Overview       string
+	ProductionCode string `json:"production_code"`
+	Runtime        int
+	SeasonNumber   int     `json:"season_number"`
+	ShowID         int     `json:"show_id"`
+	StillPath      string  `json:"still_path"`
+	VoteAverage    float32 `json:"vote_average"`
+	VoteCount      int     `json:"vote_count"`
+}
+
 // TvExternalIds struct
 type TvExternalIds struct {
 	ID          int
@@ -225,33 +395,36 @@ type TvKeywords struct {
 
 // TvRecommendations struct for TV show recommendations.
 type TvRecommendations struct {
-	Page    int `json:"page"`
-	Results []struct {
-		BackdropPath     string   `json:"backdrop_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		GenreIDs         []int    `json:"genre_ids"`
-		ID               int      `json:"id"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		OriginCountry    []string `json:"origin_country"`
-		PosterPath       string   `json:"poster_path"`
-		Popularity       float32  `json:"popularity"`
-		Name             string   `json:"name"`
-		Networks         []struct {
-			ID   int `json:"id"`
-			Logo struct {
-				Path        string  `json:"path"`
-				AspectRatio float32 `json:"aspect_ratio"`
-			} `json:"logo"`
-			Name          string `json:"name"`
-			OriginCountry string `json:"origin_country"`
-		} `json:"networks"`
-		VoteAverage float32 `json:"vote_average"`
-		VoteCount   uint32  `json:"vote_count"`
-	} `json:"results"`
-	TotalPages   int `json:"total_pages"`
-	TotalResults int `json:"total_results"`
+	Page         int                `json:"page"`
+	Results      []TvRecommendation `json:"results"`
+	TotalPages   int                `json:"total_pages"`
+	TotalResults int                `json:"total_results"`
+}
+
+// TvRecommendation struct
+type TvRecommendation struct {
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIDs         []int    `json:"genre_ids"`
+	ID               int      `json:"id"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	OriginCountry    []string `json:"origin_country"`
+	PosterPath       string   `json:"poster_path"`
+	Popularity       float32  `json:"popularity"`
+	Name             string   `json:"name"`
+	Networks         []struct {
+		ID   int `json:"id"`
+		Logo struct {
+			Path        string  `json:"path"`
+			AspectRatio float32 `json:"aspect_ratio"`
+		} `json:"logo"`
+		Name          string `json:"name"`
+		OriginCountry string `json:"origin_country"`
+	} `json:"networks"`
+	VoteAverage float32 `json:"vote_average"`
+	VoteCount   uint32  `json:"vote_count"`
 }
 
 // TvTranslations struct
@@ -291,9 +464,12 @@ func (tmdb *TMDb) GetTvInfo(id int, options map[string]string) (*TV, error) {
 		"language":           {},
 		"append_to_response": {}}
 	var tvInfo TV
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &tvInfo)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v", id), query)
+	result, err := tmdb.getTmdb(uri, &tvInfo)
 	return result.(*TV), err
 }
 
@@ -301,11 +477,26 @@ func (tmdb *TMDb) GetTvInfo(id int, options map[string]string) (*TV, error) {
 // https://developers.themoviedb.org/3/tv/get-tv-account-states
 func (tmdb *TMDb) GetTvAccountStates(id int, sessionID string) (*TvAccountState, error) {
 	var state TvAccountState
-	uri := fmt.Sprintf("%s/tv/%v/account_states?api_key=%s&session_id=%s", baseURL, id, tmdb.apiKey, sessionID)
-	result, err := getTmdb(uri, &state)
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/account_states", id), url.Values{"session_id": {sessionID}})
+	result, err := tmdb.getTmdb(uri, &state)
 	return result.(*TvAccountState), err
 }
 
+// GetTvAggregateCredits gets the cast and crew of every season of a TV show, with the episode count of each role and job
+// https://developers.themoviedb.org/3/tv/get-tv-aggregate-credits
+func (tmdb *TMDb) GetTvAggregateCredits(id int, options map[string]string) (*TvAggregateCredits, error) {
+	var availableOptions = map[string]struct{}{
+		"language": {}}
+	var credits TvAggregateCredits
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/aggregate_credits", id), query)
+	result, err := tmdb.getTmdb(uri, &credits)
+	return result.(*TvAggregateCredits), err
+}
+
 // GetTvAiringToday gets the list of TV shows that air today
 // https://developers.themoviedb.org/3/tv/get-tv-airing-today
 func (tmdb *TMDb) GetTvAiringToday(options map[string]string) (*TvPagedResults, error) {
@@ -314,9 +505,12 @@ func (tmdb *TMDb) GetTvAiringToday(options map[string]string) (*TvPagedResults,
 		"language": {},
 		"timezone": {}}
 	var onAir TvPagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/airing_today?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &onAir)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/tv/airing_today", query)
+	result, err := tmdb.getTmdb(uri, &onAir)
 	return result.(*TvPagedResults), err
 }
 
@@ -324,8 +518,8 @@ func (tmdb *TMDb) GetTvAiringToday(options map[string]string) (*TvPagedResults,
 // https://developers.themoviedb.org/3/tv/get-tv-alternative-titles
 func (tmdb *TMDb) GetTvAlternativeTitles(id int) (*TvAlternativeTitles, error) {
 	var titles TvAlternativeTitles
-	uri := fmt.Sprintf("%s/tv/%v/alternative_titles?api_key=%s", baseURL, id, tmdb.apiKey)
-	result, err := getTmdb(uri, &titles)
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/alternative_titles", id), nil)
+	result, err := tmdb.getTmdb(uri, &titles)
 	return result.(*TvAlternativeTitles), err
 }
 
@@ -336,12 +530,24 @@ func (tmdb *TMDb) GetTvChanges(id int, options map[string]string) (*TvChanges, e
 		"start_date": {},
 		"end_date":   {}}
 	var changes TvChanges
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/changes?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &changes)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/changes", id), query)
+	result, err := tmdb.getTmdb(uri, &changes)
 	return result.(*TvChanges), err
 }
 
+// GetTvContentRatings gets the content ratings of a TV show, per country
+// https://developers.themoviedb.org/3/tv/get-tv-content-ratings
+func (tmdb *TMDb) GetTvContentRatings(id int) (*TvContentRatings, error) {
+	var ratings TvContentRatings
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/content_ratings", id), nil)
+	result, err := tmdb.getTmdb(uri, &ratings)
+	return result.(*TvContentRatings), err
+}
+
 // GetTvCredits gets the credits for a specific TV show id
 // https://developers.themoviedb.org/3/tv/get-tv-credits
 func (tmdb *TMDb) GetTvCredits(id int, options map[string]string) (*TvCredits, error) {
@@ -349,21 +555,51 @@ func (tmdb *TMDb) GetTvCredits(id int, options map[string]string) (*TvCredits, e
 		"language":           {},
 		"append_to_response": {}}
 	var credits TvCredits
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/credits?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &credits)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/credits", id), query)
+	result, err := tmdb.getTmdb(uri, &credits)
 	return result.(*TvCredits), err
 }
 
+// GetTvEpisodeGroups gets the episode groups of a TV show
+// https://developers.themoviedb.org/3/tv/get-tv-episode-groups
+func (tmdb *TMDb) GetTvEpisodeGroups(id int) (*TvEpisodeGroups, error) {
+	var groups TvEpisodeGroups
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/episode_groups", id), nil)
+	result, err := tmdb.getTmdb(uri, &groups)
+	return result.(*TvEpisodeGroups), err
+}
+
+// GetTvEpisodeGroup gets the groups and ordered episodes of an episode group
+// https://developers.themoviedb.org/3/tv-episode-groups/get-tv-episode-group-details
+func (tmdb *TMDb) GetTvEpisodeGroup(id string, options map[string]string) (*TvEpisodeGroup, error) {
+	var availableOptions = map[string]struct{}{
+		"language": {}}
+	var group TvEpisodeGroup
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/episode_group/%v", url.PathEscape(id)), query)
+	result, err := tmdb.getTmdb(uri, &group)
+	return result.(*TvEpisodeGroup), err
+}
+
 // GetTvExternalIds gets the external ids for a TV series
 // https://developers.themoviedb.org/3/tv/get-tv-external-ids
 func (tmdb *TMDb) GetTvExternalIds(showID int, options map[string]string) (*TvExternalIds, error) {
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var ids TvExternalIds
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/external_ids?api_key=%s%s", baseURL, showID, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &ids)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/external_ids", showID), query)
+	result, err := tmdb.getTmdb(uri, &ids)
 	return result.(*TvExternalIds), err
 }
 
@@ -374,9 +610,12 @@ func (tmdb *TMDb) GetTvImages(id int, options map[string]string) (*TvImages, err
 		"language":               {},
 		"include_image_language": {}}
 	var images TvImages
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/images?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &images)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/images", id), query)
+	result, err := tmdb.getTmdb(uri, &images)
 	return result.(*TvImages), err
 }
 
@@ -386,9 +625,12 @@ func (tmdb *TMDb) GetTvKeywords(id int, options map[string]string) (*TvKeywords,
 	var availableOptions = map[string]struct{}{
 		"append_to_response": {}}
 	var keywords TvKeywords
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/keywords?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &keywords)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/keywords", id), query)
+	result, err := tmdb.getTmdb(uri, &keywords)
 	return result.(*TvKeywords), err
 }
 
@@ -399,9 +641,12 @@ func (tmdb *TMDb) GetTvRecommendations(id int, options map[string]string) (*TvRe
 		"language": {},
 		"page":     {}}
 	var tvRec TvRecommendations
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/recommendations?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &tvRec)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/recommendations", id), query)
+	result, err := tmdb.getTmdb(uri, &tvRec)
 	return result.(*TvRecommendations), err
 }
 
@@ -409,8 +654,8 @@ func (tmdb *TMDb) GetTvRecommendations(id int, options map[string]string) (*TvRe
 // https://developers.themoviedb.org/3/tv/get-latest-tv
 func (tmdb *TMDb) GetTvLatest() (*TV, error) {
 	var tv TV
-	uri := fmt.Sprintf("%s/tv/latest?api_key=%s", baseURL, tmdb.apiKey)
-	result, err := getTmdb(uri, &tv)
+	uri := tmdb.buildURL("/tv/latest", nil)
+	result, err := tmdb.getTmdb(uri, &tv)
 	return result.(*TV), err
 }
 
@@ -421,9 +666,12 @@ func (tmdb *TMDb) GetTvOnTheAir(options map[string]string) (*TvPagedResults, err
 		"page":     {},
 		"language": {}}
 	var onAir TvPagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/on_the_air?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &onAir)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/tv/on_the_air", query)
+	result, err := tmdb.getTmdb(uri, &onAir)
 	return result.(*TvPagedResults), err
 }
 
@@ -434,9 +682,12 @@ func (tmdb *TMDb) GetTvPopular(options map[string]string) (*TvPagedResults, erro
 		"page":     {},
 		"language": {}}
 	var onAir TvPagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/popular?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &onAir)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/tv/popular", query)
+	result, err := tmdb.getTmdb(uri, &onAir)
 	return result.(*TvPagedResults), err
 }
 
@@ -448,9 +699,12 @@ func (tmdb *TMDb) GetTvSimilar(id int, options map[string]string) (*TvPagedResul
 		"language":           {},
 		"append_to_response": {}}
 	var similar TvPagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/similar?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &similar)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/similar", id), query)
+	result, err := tmdb.getTmdb(uri, &similar)
 	return result.(*TvPagedResults), err
 }
 
@@ -461,9 +715,12 @@ func (tmdb *TMDb) GetTvTopRated(options map[string]string) (*TvPagedResults, err
 		"page":     {},
 		"language": {}}
 	var onAir TvPagedResults
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/top_rated?api_key=%s%s", baseURL, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &onAir)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/tv/top_rated", query)
+	result, err := tmdb.getTmdb(uri, &onAir)
 	return result.(*TvPagedResults), err
 }
 
@@ -471,8 +728,8 @@ func (tmdb *TMDb) GetTvTopRated(options map[string]string) (*TvPagedResults, err
 // https://developers.themoviedb.org/3/tv/get-tv-translations
 func (tmdb *TMDb) GetTvTranslations(id int) (*TvTranslations, error) {
 	var translations TvTranslations
-	uri := fmt.Sprintf("%s/tv/%v/translations?api_key=%s", baseURL, id, tmdb.apiKey)
-	result, err := getTmdb(uri, &translations)
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/translations", id), nil)
+	result, err := tmdb.getTmdb(uri, &translations)
 	return result.(*TvTranslations), err
 }
 
@@ -482,8 +739,44 @@ func (tmdb *TMDb) GetTvVideos(id int, options map[string]string) (*TvVideos, err
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var videos TvVideos
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/videos?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &videos)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/videos", id), query)
+	result, err := tmdb.getTmdb(uri, &videos)
 	return result.(*TvVideos), err
 }
+
+// GetTvWatchProviders gets where a TV show can be streamed, rented or bought, per country
+// https://developers.themoviedb.org/3/tv/get-tv-watch-providers
+func (tmdb *TMDb) GetTvWatchProviders(id int) (*WatchProviders, error) {
+	var providers WatchProviders
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/watch/providers", id), nil)
+	result, err := tmdb.getTmdb(uri, &providers)
+	return result.(*WatchProviders), err
+}
+
+// RateTv lets users rate a TV show, from 0.5 to 10 in steps of 0.5
+// https://developers.themoviedb.org/3/tv/rate-tv-show
+func (tmdb *TMDb) RateTv(id int, sessionID string, value float32) (*Status, error) {
+	return tmdb.rate(fmt.Sprintf("/tv/%v/rating", id), url.Values{"session_id": {sessionID}}, value)
+}
+
+// DeleteTvRating removes the rating of a TV show
+// https://developers.themoviedb.org/3/tv/delete-tv-show-rating
+func (tmdb *TMDb) DeleteTvRating(id int, sessionID string) (*Status, error) {
+	return tmdb.deleteRating(fmt.Sprintf("/tv/%v/rating", id), url.Values{"session_id": {sessionID}})
+}
+
+// RateTvAsGuest lets guests rate a TV show, from 0.5 to 10 in steps of 0.5
+// https://developers.themoviedb.org/3/tv/rate-tv-show
+func (tmdb *TMDb) RateTvAsGuest(id int, guestSessionID string, value float32) (*Status, error) {
+	return tmdb.rate(fmt.Sprintf("/tv/%v/rating", id), url.Values{"guest_session_id": {guestSessionID}}, value)
+}
+
+// DeleteTvRatingAsGuest removes the rating of a TV show made by a guest
+// https://developers.themoviedb.org/3/tv/delete-tv-show-rating
+func (tmdb *TMDb) DeleteTvRatingAsGuest(id int, guestSessionID string) (*Status, error) {
+	return tmdb.deleteRating(fmt.Sprintf("/tv/%v/rating", id), url.Values{"guest_session_id": {guestSessionID}})
+}
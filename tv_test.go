@@ -1,6 +1,8 @@
 package tmdb
 
 import (
+	"net/http"
+
 	. "gopkg.in/check.v1"
 )
 
@@ -213,3 +215,117 @@ func (s *TmdbSuite) TestGetTvVideos(c *C) {
 	c.Assert(engResult.Results[0].Iso639_1, Equals, "en")
 	c.Assert(len(engResult.Results) <= allResultsLength, Equals, true)
 }
+
+func (s *TmdbSuite) TestGetTvContentRatings(c *C) {
+	result, err := s.tmdb.GetTvContentRatings(gameOfThronesID)
+	s.baseTest(&result, err, c)
+	rating, ok := result.Country("US")
+	c.Assert(ok, Equals, true)
+	c.Assert(rating.Rating, Equals, "TV-MA")
+}
+
+func (s *TmdbSuite) TestGetTvEpisodeGroups(c *C) {
+	result, err := s.tmdb.GetTvEpisodeGroups(gameOfThronesID)
+	s.baseTest(&result, err, c)
+}
+
+func (s *LocalSuite) TestTvContentRatingsAndEpisodeGroups(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tv/1399/content_ratings":
+			w.Write([]byte(`{"id": 1399, "results": [
+				{"descriptors": [], "iso_3166_1": "DE", "rating": "16"},
+				{"descriptors": ["Violence"], "iso_3166_1": "US", "rating": "TV-MA"}
+			]}`))
+		case "/tv/1399/episode_groups":
+			w.Write([]byte(`{"id": 1399, "results": [
+				{"description": "", "episode_count": 73, "group_count": 8, "id": "5b11ba820e0a265847002c6e", "name": "Seasons", "network": null, "type": 6}
+			]}`))
+		case "/tv/episode_group/5b11ba820e0a265847002c6e":
+			c.Check(r.URL.Query().Get("language"), Equals, "en")
+			w.Write([]byte(`{"description": "", "episode_count": 73, "group_count": 8, "id": "5b11ba820e0a265847002c6e", "name": "Seasons",
+				"network": {"id": 49, "logo_path": "/hbo.png", "name": "HBO", "origin_country": "US"}, "type": 6,
+				"groups": [{"id": "5b11ba9e0e0a26584b002a3c", "name": "Season 1", "order": 0, "locked": true, "episodes": [
+					{"air_date": "2011-04-17", "episode_number": 1, "id": 63056, "name": "Winter Is Coming", "order": 0, "season_number": 1, "show_id": 1399},
+					{"air_date": "2011-04-24", "episode_number": 2, "id": 63057, "name": "The Kingsroad", "order": 1, "season_number": 1, "show_id": 1399}
+				]}]}`))
+		default:
+			c.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	ratings, err := tmdb.GetTvContentRatings(gameOfThronesID)
+	c.Assert(err, IsNil)
+	us, ok := ratings.Country("US")
+	c.Assert(ok, Equals, true)
+	c.Assert(us, DeepEquals, TvContentRating{Descriptors: []string{"Violence"}, Iso3166_1: "US", Rating: "TV-MA"})
+	_, ok = ratings.Country("FR")
+	c.Assert(ok, Equals, false)
+
+	groups, err := tmdb.GetTvEpisodeGroups(gameOfThronesID)
+	c.Assert(err, IsNil)
+	c.Assert(groups.Results, HasLen, 1)
+	c.Assert(groups.Results[0].Type, Equals, EpisodeGroupProduction)
+	c.Assert(groups.Results[0].Type.String(), Equals, "Production")
+	c.Assert(groups.Results[0].Network, IsNil)
+
+	group, err := tmdb.GetTvEpisodeGroup(groups.Results[0].ID, Options(WithLanguage("en")))
+	c.Assert(err, IsNil)
+	c.Assert(group.Network.Name, Equals, "HBO")
+	c.Assert(group.Groups, HasLen, 1)
+	c.Assert(group.Groups[0].Locked, Equals, true)
+	c.Assert(group.Groups[0].Episodes, HasLen, 2)
+	c.Assert(group.Groups[0].Episodes[1].Name, Equals, "The Kingsroad")
+	c.Assert(group.Groups[0].Episodes[1].Order, Equals, 1)
+}
+
+const aggregateCreditsFixture = `{
+	"cast": [{"adult": false, "gender": 2, "id": 22970, "known_for_department": "Acting", "name": "Peter Dinklage", "original_name": "Peter Dinklage",
+		"roles": [{"credit_id": "5256c8b219c2956ff6047cd8", "character": "Tyrion Lannister", "episode_count": 67}], "total_episode_count": 67, "order": 0}],
+	"crew": [{"adult": false, "gender": 2, "id": 9813, "known_for_department": "Writing", "name": "David Benioff", "original_name": "David Benioff",
+		"jobs": [{"credit_id": "5256c8c219c2956ff604858a", "job": "Executive Producer", "episode_count": 73}, {"credit_id": "54eef167c3a3686d5e005d9a", "job": "Writer", "episode_count": 51}],
+		"department": "Production", "total_episode_count": 73}],
+	"id": 1399
+}`
+
+func (s *TmdbSuite) TestGetTvAggregateCredits(c *C) {
+	result, err := s.tmdb.GetTvAggregateCredits(gameOfThronesID, nil)
+	s.baseTest(&result, err, c)
+	c.Assert(result.Cast, Not(HasLen), 0)
+}
+
+func (s *LocalSuite) TestTvAggregateCredits(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/tv/1399/aggregate_credits", "/tv/1399/season/1/aggregate_credits":
+			w.Write([]byte(aggregateCreditsFixture))
+		case "/tv/1399/season/1":
+			c.Check(r.URL.Query().Get("append_to_response"), Equals, "aggregate_credits")
+			w.Write([]byte(`{"id": 3624, "season_number": 1, "aggregate_credits": ` + aggregateCreditsFixture + `}`))
+		default:
+			c.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	series, err := tmdb.GetTvAggregateCredits(gameOfThronesID, nil)
+	c.Assert(err, IsNil)
+	season, err := tmdb.GetTvSeasonAggregateCredits(gameOfThronesID, 1)
+	c.Assert(err, IsNil)
+	info, err := tmdb.GetTvSeasonInfo(gameOfThronesID, 1, Options(WithAppendToResponse("aggregate_credits")))
+	c.Assert(err, IsNil)
+
+	for _, credits := range []*TvAggregateCredits{series, season, info.AggregateCredits} {
+		c.Assert(credits.Cast, HasLen, 1)
+		c.Assert(credits.Cast[0].TotalEpisodeCount, Equals, 67)
+		c.Assert(credits.Cast[0].Roles, DeepEquals, []TvAggregateRole{
+			{CreditID: "5256c8b219c2956ff6047cd8", Character: "Tyrion Lannister", EpisodeCount: 67},
+		})
+		c.Assert(credits.Crew, HasLen, 1)
+		c.Assert(credits.Crew[0].Department, Equals, "Production")
+		c.Assert(credits.Crew[0].Jobs, HasLen, 2)
+		c.Assert(credits.Crew[0].Jobs[1].Job, Equals, "Writer")
+		c.Assert(credits.Crew[0].Jobs[1].EpisodeCount, Equals, 51)
+	}
+}
@@ -2,6 +2,7 @@ package tmdb
 
 import (
 	"fmt"
+	"net/url"
 )
 
 // TvEpisode struct
@@ -47,9 +48,12 @@ func (tmdb *TMDb) GetTvEpisodeInfo(showID, seasonNum, episodeNum int, options ma
 		"language":           {},
 		"append_to_response": {}}
 	var episode TvEpisode
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/episode/%v?api_key=%s%s", baseURL, showID, seasonNum, episodeNum, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &episode)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/episode/%v", showID, seasonNum, episodeNum), query)
+	result, err := tmdb.getTmdb(uri, &episode)
 	return result.(*TvEpisode), err
 }
 
@@ -60,9 +64,12 @@ func (tmdb *TMDb) GetTvEpisodeChanges(id int, options map[string]string) (*TvCha
 		"start_date": {},
 		"end_date":   {}}
 	var changes TvChanges
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/episode/%v/changes?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &changes)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/episode/%v/changes", id), query)
+	result, err := tmdb.getTmdb(uri, &changes)
 	return result.(*TvChanges), err
 }
 
@@ -70,8 +77,8 @@ func (tmdb *TMDb) GetTvEpisodeChanges(id int, options map[string]string) (*TvCha
 // https://developers.themoviedb.org/3/tv-episodes/get-tv-episode-credits
 func (tmdb *TMDb) GetTvEpisodeCredits(showID, seasonNum, episodeNum int) (*TvCredits, error) {
 	var credits TvCredits
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/episode/%v/credits?api_key=%s", baseURL, showID, seasonNum, episodeNum, tmdb.apiKey)
-	result, err := getTmdb(uri, &credits)
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/episode/%v/credits", showID, seasonNum, episodeNum), nil)
+	result, err := tmdb.getTmdb(uri, &credits)
 	return result.(*TvCredits), err
 }
 
@@ -81,9 +88,12 @@ func (tmdb *TMDb) GetTvEpisodeExternalIds(showID, seasonNum, episodeNum int, opt
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var ids TvExternalIds
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/episode/%v/external_ids?api_key=%s%s", baseURL, showID, seasonNum, episodeNum, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &ids)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/episode/%v/external_ids", showID, seasonNum, episodeNum), query)
+	result, err := tmdb.getTmdb(uri, &ids)
 	return result.(*TvExternalIds), err
 }
 
@@ -91,8 +101,8 @@ func (tmdb *TMDb) GetTvEpisodeExternalIds(showID, seasonNum, episodeNum int, opt
 // https://developers.themoviedb.org/3/tv-episodes/get-tv-episode-images
 func (tmdb *TMDb) GetTvEpisodeImages(showID, seasonNum, episodeNum int) (*TvEpisodeImages, error) {
 	var images TvEpisodeImages
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/episode/%v/images?api_key=%s", baseURL, showID, seasonNum, episodeNum, tmdb.apiKey)
-	result, err := getTmdb(uri, &images)
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/episode/%v/images", showID, seasonNum, episodeNum), nil)
+	result, err := tmdb.getTmdb(uri, &images)
 	return result.(*TvEpisodeImages), err
 }
 
@@ -102,8 +112,35 @@ func (tmdb *TMDb) GetTvEpisodeVideos(showID, seasonNum, episodeNum int, options
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var videos TvVideos
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/episode/%v/videos?api_key=%s%s", baseURL, showID, seasonNum, episodeNum, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &videos)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/episode/%v/videos", showID, seasonNum, episodeNum), query)
+	result, err := tmdb.getTmdb(uri, &videos)
 	return result.(*TvVideos), err
 }
+
+// RateTvEpisode lets users rate a TV episode, from 0.5 to 10 in steps of 0.5
+// https://developers.themoviedb.org/3/tv-episodes/rate-tv-episode
+func (tmdb *TMDb) RateTvEpisode(showID, seasonNum, episodeNum int, sessionID string, value float32) (*Status, error) {
+	return tmdb.rate(fmt.Sprintf("/tv/%v/season/%v/episode/%v/rating", showID, seasonNum, episodeNum), url.Values{"session_id": {sessionID}}, value)
+}
+
+// DeleteTvEpisodeRating removes the rating of a TV episode
+// https://developers.themoviedb.org/3/tv-episodes/delete-tv-episode-rating
+func (tmdb *TMDb) DeleteTvEpisodeRating(showID, seasonNum, episodeNum int, sessionID string) (*Status, error) {
+	return tmdb.deleteRating(fmt.Sprintf("/tv/%v/season/%v/episode/%v/rating", showID, seasonNum, episodeNum), url.Values{"session_id": {sessionID}})
+}
+
+// RateTvEpisodeAsGuest lets guests rate a TV episode, from 0.5 to 10 in steps of 0.5
+// https://developers.themoviedb.org/3/tv-episodes/rate-tv-episode
+func (tmdb *TMDb) RateTvEpisodeAsGuest(showID, seasonNum, episodeNum int, guestSessionID string, value float32) (*Status, error) {
+	return tmdb.rate(fmt.Sprintf("/tv/%v/season/%v/episode/%v/rating", showID, seasonNum, episodeNum), url.Values{"guest_session_id": {guestSessionID}}, value)
+}
+
+// DeleteTvEpisodeRatingAsGuest removes the rating of a TV episode made by a guest
+// https://developers.themoviedb.org/3/tv-episodes/delete-tv-episode-rating
+func (tmdb *TMDb) DeleteTvEpisodeRatingAsGuest(showID, seasonNum, episodeNum int, guestSessionID string) (*Status, error) {
+	return tmdb.deleteRating(fmt.Sprintf("/tv/%v/season/%v/episode/%v/rating", showID, seasonNum, episodeNum), url.Values{"guest_session_id": {guestSessionID}})
+}
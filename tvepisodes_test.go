@@ -60,3 +60,25 @@ func (s *TmdbSuite) TestGetTvEpisodeVideos(c *C) {
 	c.Assert(result.ID, Equals, gameOfThronesPilotID)
 	c.Assert(result.Results, NotNil)
 }
+
+func (s *LocalSuite) TestRateTvAndEpisode(c *C) {
+	tmdb, server, requests := s.newWriteServer(c)
+	defer server.Close()
+
+	_, err := tmdb.RateTv(gameOfThronesID, "session", 10)
+	c.Assert(err, IsNil)
+	_, err = tmdb.DeleteTvRating(gameOfThronesID, "session")
+	c.Assert(err, IsNil)
+	_, err = tmdb.RateTvEpisode(gameOfThronesID, 1, 9, "session", 9.5)
+	c.Assert(err, IsNil)
+	_, err = tmdb.DeleteTvEpisodeRating(gameOfThronesID, 1, 9, "session")
+	c.Assert(err, IsNil)
+
+	c.Assert(*requests, HasLen, 4)
+	c.Check((*requests)[0].method+" "+(*requests)[0].path, Equals, "POST /tv/1399/rating")
+	c.Check((*requests)[0].body, DeepEquals, map[string]interface{}{"value": 10.0})
+	c.Check((*requests)[1].method+" "+(*requests)[1].path, Equals, "DELETE /tv/1399/rating")
+	c.Check((*requests)[2].method+" "+(*requests)[2].path, Equals, "POST /tv/1399/season/1/episode/9/rating")
+	c.Check((*requests)[2].body, DeepEquals, map[string]interface{}{"value": 9.5})
+	c.Check((*requests)[3].method+" "+(*requests)[3].path, Equals, "DELETE /tv/1399/season/1/episode/9/rating")
+}
@@ -6,15 +6,16 @@ import (
 
 // TvSeason struct
 type TvSeason struct {
-	ID           int
-	AirDate      string `json:"air_date"`
-	Name         string
-	Overview     string
-	PosterPath   string `json:"poster_path"`
-	SeasonNumber int    `json:"season_number"`
-	Episodes     []TvEpisode
-	Credits      *TvCredits
-	ExternalIDs  *TvSeasonExternalIds `json:"external_ids,omitempty"`
+	ID               int
+	AirDate          string `json:"air_date"`
+	Name             string
+	Overview         string
+	PosterPath       string `json:"poster_path"`
+	SeasonNumber     int    `json:"season_number"`
+	Episodes         []TvEpisode
+	Credits          *TvCredits
+	ExternalIDs      *TvSeasonExternalIds `json:"external_ids,omitempty"`
+	AggregateCredits *TvAggregateCredits  `json:"aggregate_credits,omitempty"`
 }
 
 // TvSeasonExternalIds struct
@@ -39,9 +40,12 @@ func (tmdb *TMDb) GetTvSeasonInfo(showID, seasonID int, options map[string]strin
 		"language":           {},
 		"append_to_response": {}}
 	var season TvSeason
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/season/%v?api_key=%s%s", baseURL, showID, seasonID, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &season)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v", showID, seasonID), query)
+	result, err := tmdb.getTmdb(uri, &season)
 	return result.(*TvSeason), err
 }
 
@@ -52,9 +56,12 @@ func (tmdb *TMDb) GetTvSeasonChanges(id int, options map[string]string) (*TvChan
 		"start_date": {},
 		"end_date":   {}}
 	var changes TvChanges
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/season/%v/changes?api_key=%s%s", baseURL, id, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &changes)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/season/%v/changes", id), query)
+	result, err := tmdb.getTmdb(uri, &changes)
 	return result.(*TvChanges), err
 }
 
@@ -62,18 +69,18 @@ func (tmdb *TMDb) GetTvSeasonChanges(id int, options map[string]string) (*TvChan
 // https://developers.themoviedb.org/3/tv-seasons/get-tv-season-credits
 func (tmdb *TMDb) GetTvSeasonCredits(showID, seasonNum int) (*TvCredits, error) {
 	var credits TvCredits
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/credits?api_key=%s", baseURL, showID, seasonNum, tmdb.apiKey)
-	result, err := getTmdb(uri, &credits)
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/credits", showID, seasonNum), nil)
+	result, err := tmdb.getTmdb(uri, &credits)
 	return result.(*TvCredits), err
 }
 
 // GetTvSeasonAggregateCredits gets all the cast & crew credits for a TV season by season number
 // https://developers.themoviedb.org/3/tv-seasons/get-tv-season-aggregate-credits
-func (tmdb *TMDb) GetTvSeasonAggregateCredits(showID, seasonNum int) (*TvCredits, error) {
-	var credits TvCredits
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/aggregate_credits?api_key=%s", baseURL, showID, seasonNum, tmdb.apiKey)
-	result, err := getTmdb(uri, &credits)
-	return result.(*TvCredits), err
+func (tmdb *TMDb) GetTvSeasonAggregateCredits(showID, seasonNum int) (*TvAggregateCredits, error) {
+	var credits TvAggregateCredits
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/aggregate_credits", showID, seasonNum), nil)
+	result, err := tmdb.getTmdb(uri, &credits)
+	return result.(*TvAggregateCredits), err
 }
 
 // GetTvSeasonExternalIds gets the external ids for a TV season by season number
@@ -82,9 +89,12 @@ func (tmdb *TMDb) GetTvSeasonExternalIds(showID, seasonNum int, options map[stri
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var ids TvExternalIds
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/external_ids?api_key=%s%s", baseURL, showID, seasonNum, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &ids)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/external_ids", showID, seasonNum), query)
+	result, err := tmdb.getTmdb(uri, &ids)
 	return result.(*TvExternalIds), err
 }
 
@@ -95,9 +105,12 @@ func (tmdb *TMDb) GetTvSeasonImages(showID, seasonNum int, options map[string]st
 		"language":               {},
 		"include_image_language": {}}
 	var images TvSeasonImages
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/images?api_key=%s%s", baseURL, showID, seasonNum, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &images)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/images", showID, seasonNum), query)
+	result, err := tmdb.getTmdb(uri, &images)
 	return result.(*TvSeasonImages), err
 }
 
@@ -107,8 +120,11 @@ func (tmdb *TMDb) GetTvSeasonVideos(showID, seasonNum int, options map[string]st
 	var availableOptions = map[string]struct{}{
 		"language": {}}
 	var videos TvVideos
-	optionsString := getOptionsString(options, availableOptions)
-	uri := fmt.Sprintf("%s/tv/%v/season/%v/videos?api_key=%s%s", baseURL, showID, seasonNum, tmdb.apiKey, optionsString)
-	result, err := getTmdb(uri, &videos)
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(fmt.Sprintf("/tv/%v/season/%v/videos", showID, seasonNum), query)
+	result, err := tmdb.getTmdb(uri, &videos)
 	return result.(*TvVideos), err
 }
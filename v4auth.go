@@ -0,0 +1,67 @@
+package tmdb
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// authApproveURL is where users approve v4 request tokens
+const authApproveURL = "https://www.themoviedb.org/auth/access"
+
+// AuthenticationTokenV4 struct
+type AuthenticationTokenV4 struct {
+	Status
+	RequestToken string `json:"request_token"`
+}
+
+// AuthenticationAccessToken struct
+type AuthenticationAccessToken struct {
+	Status
+	AccessToken     string `json:"access_token"`
+	AccountObjectID string `json:"account_id"`
+}
+
+// CreateAuthRequestTokenV4 creates a request token for the v4 user
+// authentication flow. Once the user has approved it at AuthApproveURL, they
+// are sent to redirectTo, when not empty, and the token can be exchanged with
+// CreateAuthAccessTokenV4. Needs Config.ReadAccessToken, failing with
+// ErrAccessTokenRequired without it.
+// https://developers.themoviedb.org/4/auth/create-request-token
+func (tmdb *TMDb) CreateAuthRequestTokenV4(redirectTo string) (*AuthenticationTokenV4, error) {
+	body := struct {
+		RedirectTo string `json:"redirect_to,omitempty"`
+	}{redirectTo}
+	var token AuthenticationTokenV4
+	result, err := tmdb.v4Tmdb(http.MethodPost, "/auth/request_token", nil, "", body, &token)
+	return result.(*AuthenticationTokenV4), err
+}
+
+// AuthApproveURL returns the page where the user approves requestToken
+func AuthApproveURL(requestToken string) string {
+	return authApproveURL + "?" + url.Values{"request_token": {requestToken}}.Encode()
+}
+
+// CreateAuthAccessTokenV4 exchanges an approved request token for a user
+// access token, to be passed to the v4 methods acting on behalf of the user.
+// Needs Config.ReadAccessToken, failing with ErrAccessTokenRequired without it.
+// https://developers.themoviedb.org/4/auth/create-access-token
+func (tmdb *TMDb) CreateAuthAccessTokenV4(requestToken string) (*AuthenticationAccessToken, error) {
+	body := struct {
+		RequestToken string `json:"request_token"`
+	}{requestToken}
+	var token AuthenticationAccessToken
+	result, err := tmdb.v4Tmdb(http.MethodPost, "/auth/access_token", nil, "", body, &token)
+	return result.(*AuthenticationAccessToken), err
+}
+
+// DeleteAuthAccessTokenV4 logs the user out by invalidating accessToken.
+// Needs Config.ReadAccessToken, failing with ErrAccessTokenRequired without it.
+// https://developers.themoviedb.org/4/auth/delete-access-token
+func (tmdb *TMDb) DeleteAuthAccessTokenV4(accessToken string) (*Status, error) {
+	body := struct {
+		AccessToken string `json:"access_token"`
+	}{accessToken}
+	var status Status
+	result, err := tmdb.v4Tmdb(http.MethodDelete, "/auth/access_token", nil, "", body, &status)
+	return result.(*Status), err
+}
@@ -0,0 +1,84 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+func (s *LocalSuite) TestAuthFlowV4(c *C) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]string
+		c.Check(json.NewDecoder(r.Body).Decode(&body), IsNil)
+		c.Check(r.Header.Get("Authorization"), Equals, "Bearer app-token")
+		switch r.Method + " " + r.URL.Path {
+		case "POST /4/auth/request_token":
+			c.Check(body, DeepEquals, map[string]string{"redirect_to": "https://example.com/done"})
+			w.Write([]byte(`{"success":true,"status_code":1,"request_token":"request"}`))
+		case "POST /4/auth/access_token":
+			c.Check(body, DeepEquals, map[string]string{"request_token": "request"})
+			w.Write([]byte(`{"success":true,"status_code":1,"access_token":"user-token","account_id":"4bc8892a017a3c0f92000002"}`))
+		case "POST /authentication/session/convert/4":
+			c.Check(body, DeepEquals, map[string]string{"access_token": "user-token"})
+			w.Write([]byte(`{"success":true,"session_id":"session"}`))
+		case "DELETE /4/auth/access_token":
+			c.Check(body, DeepEquals, map[string]string{"access_token": "user-token"})
+			w.Write([]byte(`{"success":true,"status_code":13}`))
+		default:
+			c.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	tmdb := Init(Config{BaseURL: server.URL, ReadAccessToken: "app-token"})
+
+	requestToken, err := tmdb.CreateAuthRequestTokenV4("https://example.com/done")
+	c.Assert(err, IsNil)
+	c.Assert(requestToken.RequestToken, Equals, "request")
+	c.Assert(AuthApproveURL(requestToken.RequestToken), Equals, "https://www.themoviedb.org/auth/access?request_token=request")
+
+	accessToken, err := tmdb.CreateAuthAccessTokenV4(requestToken.RequestToken)
+	c.Assert(err, IsNil)
+	c.Assert(accessToken.AccessToken, Equals, "user-token")
+	c.Assert(accessToken.AccountObjectID, Equals, "4bc8892a017a3c0f92000002")
+
+	session, err := tmdb.ConvertAuthAccessToken(accessToken.AccessToken)
+	c.Assert(err, IsNil)
+	c.Assert(session.SessionID, Equals, "session")
+
+	status, err := tmdb.DeleteAuthAccessTokenV4(accessToken.AccessToken)
+	c.Assert(err, IsNil)
+	c.Assert(status.Success, Equals, true)
+}
+
+func (s *LocalSuite) TestAuthFlowV4NeedsReadAccessToken(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		c.Errorf("unexpected request %s %s", r.Method, r.URL)
+	})
+	defer server.Close()
+
+	_, err := tmdb.CreateAuthRequestTokenV4("")
+	c.Assert(errors.Is(err, ErrAccessTokenRequired), Equals, true)
+	_, err = tmdb.CreateAuthAccessTokenV4("request")
+	c.Assert(errors.Is(err, ErrAccessTokenRequired), Equals, true)
+	_, err = tmdb.DeleteAuthAccessTokenV4("user-token")
+	c.Assert(errors.Is(err, ErrAccessTokenRequired), Equals, true)
+}
+
+func (s *LocalSuite) TestValidateAuthTokenKeepsPasswordOutOfURL(c *C) {
+	tmdb, server, requests := s.newWriteServer(c)
+	defer server.Close()
+
+	_, err := tmdb.GetAuthValidateToken("request", "user", "hunter2")
+	c.Assert(err, IsNil)
+	c.Assert(*requests, HasLen, 1)
+	req := (*requests)[0]
+	c.Assert(req.method, Equals, "POST")
+	c.Assert(req.path, Equals, "/authentication/token/validate_with_login")
+	c.Assert(strings.Contains(req.query.Encode(), "hunter2"), Equals, false)
+	c.Assert(req.body, DeepEquals, map[string]interface{}{"request_token": "request", "username": "user", "password": "hunter2"})
+}
@@ -0,0 +1,211 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListV4SortBy type
+type ListV4SortBy string
+
+// Sort orders of a v4 list
+const (
+	ListV4SortOriginalOrderAsc       ListV4SortBy = "original_order.asc"
+	ListV4SortOriginalOrderDesc      ListV4SortBy = "original_order.desc"
+	ListV4SortVoteAverageAsc         ListV4SortBy = "vote_average.asc"
+	ListV4SortVoteAverageDesc        ListV4SortBy = "vote_average.desc"
+	ListV4SortPrimaryReleaseDateAsc  ListV4SortBy = "primary_release_date.asc"
+	ListV4SortPrimaryReleaseDateDesc ListV4SortBy = "primary_release_date.desc"
+	ListV4SortTitleAsc               ListV4SortBy = "title.asc"
+	ListV4SortTitleDesc              ListV4SortBy = "title.desc"
+)
+
+// ListV4 struct. Results mixes movies (*MultiSearchMovieInfo) and TV shows
+// (*MultiSearchTvInfo).
+type ListV4 struct {
+	ID            int
+	Name          string
+	Description   string
+	Public        bool
+	Iso639_1      string  `json:"iso_639_1"`
+	Iso3166_1     string  `json:"iso_3166_1"`
+	AverageRating float32 `json:"average_rating"`
+	BackdropPath  string  `json:"backdrop_path"`
+	PosterPath    string  `json:"poster_path"`
+	Revenue       int64
+	Runtime       int
+	SortBy        ListV4SortBy `json:"sort_by"`
+	CreatedBy     ListV4Author `json:"created_by"`
+	Comments      map[string]string
+	ObjectIDs     map[string]string `json:"object_ids"`
+	Page          int
+	Results       MultiSearchResultsInfo
+	TotalPages    int `json:"total_pages"`
+	TotalResults  int `json:"total_results"`
+}
+
+// ListV4Author struct
+type ListV4Author struct {
+	ID           string
+	Name         string
+	Username     string
+	GravatarHash string `json:"gravatar_hash"`
+}
+
+// Comment returns the comment left on an item of the list, if any
+func (list *ListV4) Comment(mediaType MediaType, mediaID int) string {
+	return list.Comments[fmt.Sprintf("%s:%d", mediaType, mediaID)]
+}
+
+// GetMoviesResults func
+func (list *ListV4) GetMoviesResults() []MultiSearchMovieInfo {
+	return MultiSearchResults{Results: list.Results}.GetMoviesResults()
+}
+
+// GetTvResults func
+func (list *ListV4) GetTvResults() []MultiSearchTvInfo {
+	return MultiSearchResults{Results: list.Results}.GetTvResults()
+}
+
+// ListV4Settings struct holds the fields of a list set by CreateListV4 and
+// UpdateListV4. Empty fields are left unchanged by UpdateListV4.
+type ListV4Settings struct {
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Iso639_1    string       `json:"iso_639_1,omitempty"`
+	Iso3166_1   string       `json:"iso_3166_1,omitempty"`
+	Public      *bool        `json:"public,omitempty"`
+	SortBy      ListV4SortBy `json:"sort_by,omitempty"`
+}
+
+// ListV4Item struct identifies a movie or TV show of a v4 list. Comment is
+// only used by UpdateListV4Items.
+type ListV4Item struct {
+	MediaType MediaType `json:"media_type"`
+	MediaID   int       `json:"media_id"`
+	Comment   string    `json:"comment,omitempty"`
+}
+
+// ListV4CreateStatus struct
+type ListV4CreateStatus struct {
+	Status
+	ID int
+}
+
+// ListV4ClearStatus struct
+type ListV4ClearStatus struct {
+	Status
+	ID           int
+	ItemsDeleted int `json:"items_deleted"`
+}
+
+// ListV4ItemsStatus struct
+type ListV4ItemsStatus struct {
+	Status
+	Results []ListV4ItemResult
+}
+
+// ListV4ItemResult struct
+type ListV4ItemResult struct {
+	MediaType MediaType `json:"media_type"`
+	MediaID   int       `json:"media_id"`
+	Success   bool
+}
+
+// ListV4ItemStatus struct
+type ListV4ItemStatus struct {
+	Status
+	ID        int
+	MediaType MediaType `json:"media_type"`
+	MediaID   int       `json:"media_id"`
+}
+
+// GetListV4 gets the details and items of a v4 list. Private lists need the
+// user access token of their owner; accessToken may be empty otherwise.
+// https://developers.themoviedb.org/4/list/get-list
+func (tmdb *TMDb) GetListV4(id int, accessToken string, options map[string]string) (*ListV4, error) {
+	var availableOptions = map[string]struct{}{
+		"page":     {},
+		"language": {},
+		"sort_by":  {}}
+	var list ListV4
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	result, err := tmdb.v4Tmdb(http.MethodGet, fmt.Sprintf("/list/%v", id), query, accessToken, nil, &list)
+	return result.(*ListV4), err
+}
+
+// CreateListV4 creates a list owned by the user of accessToken
+// https://developers.themoviedb.org/4/list/create-list
+func (tmdb *TMDb) CreateListV4(accessToken string, settings ListV4Settings) (*ListV4CreateStatus, error) {
+	var created ListV4CreateStatus
+	result, err := tmdb.v4Tmdb(http.MethodPost, "/list", nil, accessToken, settings, &created)
+	return result.(*ListV4CreateStatus), err
+}
+
+// UpdateListV4 updates the details of a list
+// https://developers.themoviedb.org/4/list/update-list
+func (tmdb *TMDb) UpdateListV4(id int, accessToken string, settings ListV4Settings) (*Status, error) {
+	var status Status
+	result, err := tmdb.v4Tmdb(http.MethodPut, fmt.Sprintf("/list/%v", id), nil, accessToken, settings, &status)
+	return result.(*Status), err
+}
+
+// ClearListV4 removes every item from a list
+// https://developers.themoviedb.org/4/list/clear-list
+func (tmdb *TMDb) ClearListV4(id int, accessToken string) (*ListV4ClearStatus, error) {
+	var status ListV4ClearStatus
+	result, err := tmdb.v4Tmdb(http.MethodGet, fmt.Sprintf("/list/%v/clear", id), nil, accessToken, nil, &status)
+	return result.(*ListV4ClearStatus), err
+}
+
+// DeleteListV4 deletes a list
+// https://developers.themoviedb.org/4/list/delete-list
+func (tmdb *TMDb) DeleteListV4(id int, accessToken string) (*Status, error) {
+	var status Status
+	result, err := tmdb.v4Tmdb(http.MethodDelete, fmt.Sprintf("/list/%v", id), nil, accessToken, nil, &status)
+	return result.(*Status), err
+}
+
+// AddListV4Items adds movies and TV shows to a list
+// https://developers.themoviedb.org/4/list/add-items
+func (tmdb *TMDb) AddListV4Items(id int, accessToken string, items ...ListV4Item) (*ListV4ItemsStatus, error) {
+	return tmdb.listV4Items(http.MethodPost, id, accessToken, items)
+}
+
+// UpdateListV4Items updates the comments of items of a list
+// https://developers.themoviedb.org/4/list/update-items
+func (tmdb *TMDb) UpdateListV4Items(id int, accessToken string, items ...ListV4Item) (*ListV4ItemsStatus, error) {
+	return tmdb.listV4Items(http.MethodPut, id, accessToken, items)
+}
+
+// RemoveListV4Items removes movies and TV shows from a list
+// https://developers.themoviedb.org/4/list/remove-items
+func (tmdb *TMDb) RemoveListV4Items(id int, accessToken string, items ...ListV4Item) (*ListV4ItemsStatus, error) {
+	return tmdb.listV4Items(http.MethodDelete, id, accessToken, items)
+}
+
+func (tmdb *TMDb) listV4Items(method string, id int, accessToken string, items []ListV4Item) (*ListV4ItemsStatus, error) {
+	body := struct {
+		Items []ListV4Item `json:"items"`
+	}{items}
+	var status ListV4ItemsStatus
+	result, err := tmdb.v4Tmdb(method, fmt.Sprintf("/list/%v/items", id), nil, accessToken, body, &status)
+	return result.(*ListV4ItemsStatus), err
+}
+
+// GetListV4ItemStatus checks whether a movie or TV show is on a list
+// https://developers.themoviedb.org/4/list/check-item-status
+func (tmdb *TMDb) GetListV4ItemStatus(id int, accessToken string, mediaType MediaType, mediaID int) (*ListV4ItemStatus, error) {
+	var status ListV4ItemStatus
+	query := url.Values{
+		"media_type": {string(mediaType)},
+		"media_id":   {strconv.Itoa(mediaID)},
+	}
+	result, err := tmdb.v4Tmdb(http.MethodGet, fmt.Sprintf("/list/%v/item_status", id), query, accessToken, nil, &status)
+	return result.(*ListV4ItemStatus), err
+}
@@ -0,0 +1,136 @@
+package tmdb
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	. "gopkg.in/check.v1"
+)
+
+const listV4Fixture = `{
+	"id": 7,
+	"name": "Watch next",
+	"public": false,
+	"sort_by": "original_order.asc",
+	"comments": {"movie:550": "Again", "tv:1399": null},
+	"page": 1,
+	"results": [
+		{"media_type": "movie", "id": 550, "title": "Fight Club"},
+		{"media_type": "tv", "id": 1399, "name": "Game of Thrones"}
+	],
+	"total_pages": 1,
+	"total_results": 2
+}`
+
+func (s *LocalSuite) TestV4BaseURL(c *C) {
+	c.Assert(Init(Config{}).v4BaseURL, Equals, "https://api.themoviedb.org/4")
+	c.Assert(Init(Config{BaseURL: "http://mirror/tmdb"}).v4BaseURL, Equals, "http://mirror/tmdb/4")
+	c.Assert(Init(Config{V4BaseURL: "http://mirror/v4/"}).v4BaseURL, Equals, "http://mirror/v4")
+}
+
+func (s *LocalSuite) TestV4NeedsAccessToken(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		c.Errorf("unexpected request to %v", r.URL)
+	})
+	defer server.Close()
+
+	list, err := tmdb.GetListV4(7, "", nil)
+	c.Assert(errors.Is(err, ErrAccessTokenRequired), Equals, true)
+	c.Assert(list, NotNil)
+}
+
+func (s *LocalSuite) TestGetListV4(c *C) {
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		c.Check(r.URL.Path, Equals, "/4/list/7")
+		c.Check(r.URL.Query().Get("sort_by"), Equals, "title.asc")
+		c.Check(r.Header.Get("Authorization"), Equals, "Bearer user-token")
+		w.Write([]byte(listV4Fixture))
+	}))
+	defer server.Close()
+	tmdb := Init(Config{BaseURL: server.URL, ReadAccessToken: "app-token", Cache: NewMemoryCache(10)})
+
+	for i := 0; i < 2; i++ {
+		list, err := tmdb.GetListV4(7, "user-token", Options(WithSortBy(string(ListV4SortTitleAsc))))
+		c.Assert(err, IsNil)
+		c.Assert(list.Name, Equals, "Watch next")
+		c.Assert(list.SortBy, Equals, ListV4SortOriginalOrderAsc)
+		c.Assert(list.Results, HasLen, 2)
+		c.Assert(list.GetMoviesResults()[0].Title, Equals, "Fight Club")
+		c.Assert(list.GetTvResults()[0].Name, Equals, "Game of Thrones")
+		c.Assert(list.Comment(MediaTypeMovie, 550), Equals, "Again")
+		c.Assert(list.Comment(MediaTypeTv, 1399), Equals, "")
+	}
+	c.Assert(hits, Equals, 2)
+}
+
+func (s *LocalSuite) TestListV4Writes(c *C) {
+	type seen struct {
+		method, path, auth string
+		body               map[string]interface{}
+	}
+	var requests []seen
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := seen{method: r.Method, path: r.URL.Path, auth: r.Header.Get("Authorization")}
+		if r.ContentLength > 0 {
+			c.Check(json.NewDecoder(r.Body).Decode(&req.body), IsNil)
+		}
+		requests = append(requests, req)
+		switch r.URL.Path {
+		case "/4/list":
+			w.Write([]byte(`{"success":true,"status_code":1,"id":7}`))
+		case "/4/list/7/items":
+			w.Write([]byte(`{"success":true,"status_code":1,"results":[{"media_type":"movie","media_id":550,"success":true},{"media_type":"tv","media_id":1399,"success":false}]}`))
+		default:
+			w.Write([]byte(`{"success":true,"status_code":1}`))
+		}
+	}))
+	defer server.Close()
+	tmdb := Init(Config{BaseURL: server.URL, ReadAccessToken: "app-token"})
+
+	public := false
+	created, err := tmdb.CreateListV4("user-token", ListV4Settings{Name: "Watch next", Iso639_1: "en", Public: &public})
+	c.Assert(err, IsNil)
+	c.Assert(created.ID, Equals, 7)
+
+	added, err := tmdb.AddListV4Items(7, "user-token",
+		ListV4Item{MediaType: MediaTypeMovie, MediaID: 550},
+		ListV4Item{MediaType: MediaTypeTv, MediaID: 1399})
+	c.Assert(err, IsNil)
+	c.Assert(added.Results, DeepEquals, []ListV4ItemResult{
+		{MediaType: MediaTypeMovie, MediaID: 550, Success: true},
+		{MediaType: MediaTypeTv, MediaID: 1399, Success: false},
+	})
+
+	_, err = tmdb.UpdateListV4Items(7, "user-token", ListV4Item{MediaType: MediaTypeMovie, MediaID: 550, Comment: "Again"})
+	c.Assert(err, IsNil)
+	_, err = tmdb.RemoveListV4Items(7, "user-token", ListV4Item{MediaType: MediaTypeTv, MediaID: 1399})
+	c.Assert(err, IsNil)
+	_, err = tmdb.UpdateListV4(7, "user-token", ListV4Settings{SortBy: ListV4SortVoteAverageDesc})
+	c.Assert(err, IsNil)
+	_, err = tmdb.DeleteListV4(7, "user-token")
+	c.Assert(err, IsNil)
+
+	c.Assert(requests, HasLen, 6)
+	for _, req := range requests {
+		c.Check(req.auth, Equals, "Bearer user-token")
+	}
+	c.Check(requests[0].method+" "+requests[0].path, Equals, "POST /4/list")
+	c.Check(requests[0].body, DeepEquals, map[string]interface{}{"name": "Watch next", "iso_639_1": "en", "public": false})
+	c.Check(requests[1].method+" "+requests[1].path, Equals, "POST /4/list/7/items")
+	c.Check(requests[1].body, DeepEquals, map[string]interface{}{"items": []interface{}{
+		map[string]interface{}{"media_type": "movie", "media_id": 550.0},
+		map[string]interface{}{"media_type": "tv", "media_id": 1399.0},
+	}})
+	c.Check(requests[2].method+" "+requests[2].path, Equals, "PUT /4/list/7/items")
+	c.Check(requests[2].body, DeepEquals, map[string]interface{}{"items": []interface{}{
+		map[string]interface{}{"media_type": "movie", "media_id": 550.0, "comment": "Again"},
+	}})
+	c.Check(requests[3].method+" "+requests[3].path, Equals, "DELETE /4/list/7/items")
+	c.Check(requests[4].method+" "+requests[4].path, Equals, "PUT /4/list/7")
+	c.Check(requests[4].body, DeepEquals, map[string]interface{}{"sort_by": "vote_average.desc"})
+	c.Check(requests[5].method+" "+requests[5].path, Equals, "DELETE /4/list/7")
+}
@@ -0,0 +1,110 @@
+package tmdb
+
+// WatchProvider struct
+type WatchProvider struct {
+	DisplayPriority   int            `json:"display_priority"`
+	DisplayPriorities map[string]int `json:"display_priorities,omitempty"`
+	LogoPath          string         `json:"logo_path"`
+	ProviderID        int            `json:"provider_id"`
+	ProviderName      string         `json:"provider_name"`
+}
+
+// WatchProviderOffers struct lists the providers of a title in one country.
+// Link points to the TMDb page listing them, as provided by JustWatch.
+type WatchProviderOffers struct {
+	Link     string
+	Flatrate []WatchProvider `json:",omitempty"`
+	Free     []WatchProvider `json:",omitempty"`
+	Ads      []WatchProvider `json:",omitempty"`
+	Rent     []WatchProvider `json:",omitempty"`
+	Buy      []WatchProvider `json:",omitempty"`
+}
+
+// Offers returns the providers offering the title through monetization
+func (offers WatchProviderOffers) Offers(monetization MonetizationType) []WatchProvider {
+	switch monetization {
+	case MonetizationFlatrate:
+		return offers.Flatrate
+	case MonetizationFree:
+		return offers.Free
+	case MonetizationAds:
+		return offers.Ads
+	case MonetizationRent:
+		return offers.Rent
+	case MonetizationBuy:
+		return offers.Buy
+	}
+	return nil
+}
+
+// WatchProviders struct holds the offers of a title, keyed by ISO 3166-1
+// country code
+type WatchProviders struct {
+	ID      int `json:",omitempty"`
+	Results map[string]WatchProviderOffers
+}
+
+// Region returns the offers of the title in an ISO 3166-1 country, and
+// whether there are any
+func (providers *WatchProviders) Region(country string) (WatchProviderOffers, bool) {
+	offers, ok := providers.Results[country]
+	return offers, ok
+}
+
+// WatchProviderList struct
+type WatchProviderList struct {
+	Results []WatchProvider
+}
+
+// WatchProviderRegions struct
+type WatchProviderRegions struct {
+	Results []WatchProviderRegion
+}
+
+// WatchProviderRegion struct
+type WatchProviderRegion struct {
+	Iso3166_1   string `json:"iso_3166_1"`
+	EnglishName string `json:"english_name"`
+	NativeName  string `json:"native_name"`
+}
+
+// GetWatchProvidersMovie gets the providers that offer movies
+// https://developers.themoviedb.org/3/watch-providers/get-movie-providers
+func (tmdb *TMDb) GetWatchProvidersMovie(options map[string]string) (*WatchProviderList, error) {
+	return tmdb.getWatchProviders("/watch/providers/movie", options)
+}
+
+// GetWatchProvidersTv gets the providers that offer TV shows
+// https://developers.themoviedb.org/3/watch-providers/get-tv-providers
+func (tmdb *TMDb) GetWatchProvidersTv(options map[string]string) (*WatchProviderList, error) {
+	return tmdb.getWatchProviders("/watch/providers/tv", options)
+}
+
+func (tmdb *TMDb) getWatchProviders(path string, options map[string]string) (*WatchProviderList, error) {
+	var availableOptions = map[string]struct{}{
+		"language":     {},
+		"watch_region": {}}
+	var providers WatchProviderList
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL(path, query)
+	result, err := tmdb.getTmdb(uri, &providers)
+	return result.(*WatchProviderList), err
+}
+
+// GetWatchProviderRegions gets the countries with watch provider data
+// https://developers.themoviedb.org/3/watch-providers/get-available-regions
+func (tmdb *TMDb) GetWatchProviderRegions(options map[string]string) (*WatchProviderRegions, error) {
+	var availableOptions = map[string]struct{}{
+		"language": {}}
+	var regions WatchProviderRegions
+	query, err := getOptions(options, availableOptions)
+	if err != nil {
+		return nil, err
+	}
+	uri := tmdb.buildURL("/watch/providers/regions", query)
+	result, err := tmdb.getTmdb(uri, &regions)
+	return result.(*WatchProviderRegions), err
+}
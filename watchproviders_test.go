@@ -0,0 +1,99 @@
+package tmdb
+
+import (
+	"fmt"
+	"net/http"
+
+	. "gopkg.in/check.v1"
+)
+
+const watchProvidersFixture = `{
+	"US": {
+		"link": "https://www.themoviedb.org/movie/550-fight-club/watch?locale=US",
+		"flatrate": [{"display_priority": 4, "logo_path": "/hulu.jpg", "provider_id": 15, "provider_name": "Hulu"}],
+		"rent": [{"display_priority": 2, "logo_path": "/apple.jpg", "provider_id": 2, "provider_name": "Apple TV"}],
+		"buy": [{"display_priority": 2, "logo_path": "/apple.jpg", "provider_id": 2, "provider_name": "Apple TV"}]
+	},
+	"DE": {
+		"link": "https://www.themoviedb.org/movie/550-fight-club/watch?locale=DE",
+		"ads": [{"display_priority": 9, "logo_path": "/pluto.jpg", "provider_id": 300, "provider_name": "Pluto TV"}]
+	}
+}`
+
+func (s *TmdbSuite) TestGetMovieWatchProviders(c *C) {
+	result, err := s.tmdb.GetMovieWatchProviders(fightClubID)
+	s.baseTest(&result, err, c)
+	c.Assert(result.ID, Equals, fightClubID)
+}
+
+func (s *TmdbSuite) TestGetTvWatchProviders(c *C) {
+	result, err := s.tmdb.GetTvWatchProviders(gameOfThronesID)
+	s.baseTest(&result, err, c)
+	c.Assert(result.ID, Equals, gameOfThronesID)
+}
+
+func (s *TmdbSuite) TestGetWatchProviderRegions(c *C) {
+	result, err := s.tmdb.GetWatchProviderRegions(nil)
+	s.baseTest(&result, err, c)
+	c.Assert(result.Results, Not(HasLen), 0)
+}
+
+func (s *LocalSuite) TestWatchProviders(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/movie/550/watch/providers":
+			fmt.Fprintf(w, `{"id": 550, "results": %s}`, watchProvidersFixture)
+		case "/tv/1399":
+			c.Check(r.URL.Query().Get("append_to_response"), Equals, "watch/providers")
+			fmt.Fprintf(w, `{"id": 1399, "watch/providers": {"results": %s}}`, watchProvidersFixture)
+		default:
+			c.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	providers, err := tmdb.GetMovieWatchProviders(fightClubID)
+	c.Assert(err, IsNil)
+	us, ok := providers.Region("US")
+	c.Assert(ok, Equals, true)
+	c.Assert(us.Link, Equals, "https://www.themoviedb.org/movie/550-fight-club/watch?locale=US")
+	c.Assert(us.Offers(MonetizationFlatrate), HasLen, 1)
+	c.Assert(us.Offers(MonetizationFlatrate)[0].ProviderName, Equals, "Hulu")
+	c.Assert(us.Offers(MonetizationRent)[0].ProviderID, Equals, 2)
+	c.Assert(us.Offers(MonetizationFree), HasLen, 0)
+	_, ok = providers.Region("FR")
+	c.Assert(ok, Equals, false)
+
+	show, err := tmdb.GetTvInfo(gameOfThronesID, Options(WithAppendToResponse("watch/providers")))
+	c.Assert(err, IsNil)
+	c.Assert(show.WatchProviders, NotNil)
+	de, _ := show.WatchProviders.Region("DE")
+	c.Assert(de.Offers(MonetizationAds)[0].ProviderName, Equals, "Pluto TV")
+}
+
+func (s *LocalSuite) TestWatchProviderLists(c *C) {
+	tmdb, server := s.newServer(c, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/watch/providers/movie", "/watch/providers/tv":
+			c.Check(r.URL.Query().Get("watch_region"), Equals, "US")
+			fmt.Fprint(w, `{"results": [{"display_priorities": {"US": 1, "CA": 3}, "display_priority": 1, "provider_id": 8, "provider_name": "Netflix"}]}`)
+		case "/watch/providers/regions":
+			fmt.Fprint(w, `{"results": [{"iso_3166_1": "DE", "english_name": "Germany", "native_name": "Deutschland"}]}`)
+		default:
+			c.Errorf("unexpected request %s", r.URL.Path)
+		}
+	})
+	defer server.Close()
+
+	for _, get := range []func(map[string]string) (*WatchProviderList, error){tmdb.GetWatchProvidersMovie, tmdb.GetWatchProvidersTv} {
+		list, err := get(Options(WithWatchRegion("US")))
+		c.Assert(err, IsNil)
+		c.Assert(list.Results, HasLen, 1)
+		c.Assert(list.Results[0].ProviderName, Equals, "Netflix")
+		c.Assert(list.Results[0].DisplayPriorities["CA"], Equals, 3)
+	}
+
+	regions, err := tmdb.GetWatchProviderRegions(nil)
+	c.Assert(err, IsNil)
+	c.Assert(regions.Results, DeepEquals, []WatchProviderRegion{{Iso3166_1: "DE", EnglishName: "Germany", NativeName: "Deutschland"}})
+}